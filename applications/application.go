@@ -4,20 +4,95 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 
 	"github.com/steve-care-software/selector/domain/selectors"
+	validator "github.com/steve-care-software/validator/applications"
+	"github.com/steve-care-software/validator/domain/grammars"
 	"github.com/steve-care-software/validator/domain/results"
 )
 
 type application struct {
-	adapter selectors.Adapter
+	adapter               selectors.Adapter
+	validatorApp          validator.Application
+	grammarBuilder        grammars.Builder
+	wildcardByte          byte
+	singleWildcardByte    byte
+	alternativesPrefix    byte
+	alternativesSuffix    byte
+	alternativesDelimiter byte
+	grammar               grammars.Grammar
 }
 
 func createApplication(
 	adapter selectors.Adapter,
+	validatorApp validator.Application,
+	grammarBuilder grammars.Builder,
+	wildcardByte byte,
+	singleWildcardByte byte,
+	alternativesPrefix byte,
+	alternativesSuffix byte,
+	alternativesDelimiter byte,
+) Application {
+	return createApplicationInternally(
+		adapter,
+		validatorApp,
+		grammarBuilder,
+		wildcardByte,
+		singleWildcardByte,
+		alternativesPrefix,
+		alternativesSuffix,
+		alternativesDelimiter,
+		nil,
+	)
+}
+
+func createApplicationWithGrammar(
+	adapter selectors.Adapter,
+	validatorApp validator.Application,
+	grammarBuilder grammars.Builder,
+	wildcardByte byte,
+	singleWildcardByte byte,
+	alternativesPrefix byte,
+	alternativesSuffix byte,
+	alternativesDelimiter byte,
+	grammar grammars.Grammar,
+) Application {
+	return createApplicationInternally(
+		adapter,
+		validatorApp,
+		grammarBuilder,
+		wildcardByte,
+		singleWildcardByte,
+		alternativesPrefix,
+		alternativesSuffix,
+		alternativesDelimiter,
+		grammar,
+	)
+}
+
+func createApplicationInternally(
+	adapter selectors.Adapter,
+	validatorApp validator.Application,
+	grammarBuilder grammars.Builder,
+	wildcardByte byte,
+	singleWildcardByte byte,
+	alternativesPrefix byte,
+	alternativesSuffix byte,
+	alternativesDelimiter byte,
+	grammar grammars.Grammar,
 ) Application {
 	out := application{
-		adapter: adapter,
+		adapter:               adapter,
+		validatorApp:          validatorApp,
+		grammarBuilder:        grammarBuilder,
+		wildcardByte:          wildcardByte,
+		singleWildcardByte:    singleWildcardByte,
+		alternativesPrefix:    alternativesPrefix,
+		alternativesSuffix:    alternativesSuffix,
+		alternativesDelimiter: alternativesDelimiter,
+		grammar:               grammar,
 	}
 
 	return &out
@@ -28,8 +103,207 @@ func (app *application) Compile(script string) (selectors.Selector, []byte, erro
 	return app.adapter.ToSelector(script)
 }
 
-// Execute executes a selector on validation result
+// CompileStrict compiles a selector, and returns an error if input remains after it
+func (app *application) CompileStrict(script string) (selectors.Selector, error) {
+	return app.adapter.ToStrictSelector(script)
+}
+
+// CompileStatements compiles statements
+func (app *application) CompileStatements(script string) (selectors.Statements, []byte, error) {
+	return app.adapter.ToStatements(script)
+}
+
+// CompileStatementsStrict compiles statements, and returns an error if input remains after them
+func (app *application) CompileStatementsStrict(script string) (selectors.Statements, error) {
+	return app.adapter.ToStrictStatements(script)
+}
+
+// ExecuteStatements executes statements on validation result
+func (app *application) ExecuteStatements(statements selectors.Statements, result results.Result) (Outputs, error) {
+	list := []Output{}
+	statementsList := statements.List()
+	for _, oneStatement := range statementsList {
+		values, err := app.Execute(oneStatement.Selector(), result)
+		if err != nil {
+			str := fmt.Sprintf("there was an error while executing the statement (name: %s): %s", oneStatement.Name(), err.Error())
+			return nil, errors.New(str)
+		}
+
+		list = append(list, createOutput(oneStatement.Name(), values))
+	}
+
+	return createOutputs(list), nil
+}
+
+// Execute executes a selector on validation result and returns the bytes of its matches, where a token keeps the channel bytes
+// of its child tokens but not the ones between its own elements, while the any, between and all selectors keep their original bytes
 func (app *application) Execute(selector selectors.Selector, result results.Result) ([][]byte, error) {
+	spans, err := app.selectorOnResult(selector, result)
+	if err != nil {
+		return nil, err
+	}
+
+	output := [][]byte{}
+	for _, oneSpan := range spans {
+		output = append(output, oneSpan.data)
+	}
+
+	return output, nil
+}
+
+// ExecuteRaw executes a selector on validation result and returns the original bytes of its matches, including their channel bytes
+func (app *application) ExecuteRaw(selector selectors.Selector, result results.Result) ([][]byte, error) {
+	return app.executeBytes(selector, result, true)
+}
+
+// ExecuteNormalized executes a selector on validation result and returns the bytes of its matches, without their channel bytes
+func (app *application) ExecuteNormalized(selector selectors.Selector, result results.Result) ([][]byte, error) {
+	return app.executeBytes(selector, result, false)
+}
+
+func (app *application) executeBytes(selector selectors.Selector, result results.Result, isRaw bool) ([][]byte, error) {
+	spans, err := app.selectorOnResult(selector, result)
+	if err != nil {
+		return nil, err
+	}
+
+	token := result.Token()
+	input := token.Block().Input()
+	values := app.valuePositions(input, token, make([]bool, len(input)))
+	output := [][]byte{}
+	for _, oneSpan := range spans {
+		// the amount of a repetition is not extracted from the input:
+		if oneSpan.isCount {
+			output = append(output, oneSpan.data)
+			continue
+		}
+
+		if isRaw {
+			output = append(output, input[oneSpan.start:oneSpan.end])
+			continue
+		}
+
+		output = append(output, app.normalizedContent(input, values, oneSpan.start, oneSpan.end))
+	}
+
+	return output, nil
+}
+
+// ExecuteMatches executes a selector on validation result and returns its matches
+func (app *application) ExecuteMatches(selector selectors.Selector, result results.Result) ([]Match, error) {
+	spans, err := app.selectorOnResult(selector, result)
+	if err != nil {
+		return nil, err
+	}
+
+	index := app.rootOffset(result)
+	output := []Match{}
+	for _, oneSpan := range spans {
+		// the spans of the any selector do not belong to a token, so they belong to the root token:
+		token := result.Token()
+		if len(oneSpan.tokens) > 0 {
+			token = oneSpan.tokens[0]
+		}
+
+		start := index + uint(oneSpan.start)
+		end := index + uint(oneSpan.end)
+		alternative := app.tokenAlternative(token)
+		output = append(output, createMatch(start, end, token.Name(), token.Path(), alternative, oneSpan.data))
+	}
+
+	return output, nil
+}
+
+// rootOffset returns the offset of the root token's input in the data given to the validator, whose channel bytes
+// preceding the root token are not part of its input
+func (app *application) rootOffset(result results.Result) uint {
+	token := result.Token()
+	trailing := app.trailingChannels(token)
+	if trailing > token.Channels() {
+		return result.Index()
+	}
+
+	// the channels of a token are the ones preceding it and the ones following it:
+	return result.Index() + token.Channels() - trailing
+}
+
+// trailingChannels returns the amount of channel bytes following the token, which are also the ones following its last child token,
+// whose preceding channel bytes are located between its starting data and its input
+func (app *application) trailingChannels(token results.Token) uint {
+	block := token.Block()
+	if !block.HasMatch() {
+		return 0
+	}
+
+	var last results.Token
+	lastStart := []byte{}
+	start := block.Input()
+	elements := block.Match().Elements()
+	for _, oneElementWithCardinality := range elements {
+		if oneElementWithCardinality.HasMatches() {
+			last = nil
+			matches := oneElementWithCardinality.Matches()
+			for _, oneElement := range matches {
+				if !oneElement.IsToken() {
+					break
+				}
+
+				last = oneElement.Token()
+				lastStart = start
+				start = last.Block().Remaining()
+			}
+		}
+
+		start = oneElementWithCardinality.Remaining()
+	}
+
+	if last == nil {
+		return 0
+	}
+
+	leading := uint(len(lastStart) - len(last.Block().Input()))
+	if leading > last.Channels() {
+		return 0
+	}
+
+	return last.Channels() - leading
+}
+
+// ExecuteNodes executes a selector on validation result and returns its matched tokens
+func (app *application) ExecuteNodes(selector selectors.Selector, result results.Result) ([]results.Token, error) {
+	if app.isBytesSelector(selector) {
+		return nil, errors.New("the any and between selectors cannot be executed as nodes because their matches are not tokens")
+	}
+
+	spans, err := app.selectorOnResult(selector, result)
+	if err != nil {
+		return nil, err
+	}
+
+	output := []results.Token{}
+	for _, oneSpan := range spans {
+		output = append(output, oneSpan.tokens...)
+	}
+
+	return output, nil
+}
+
+func (app *application) isBytesSelector(selector selectors.Selector) bool {
+	if !selector.IsUnion() {
+		return selector.IsAny() || selector.IsBetween()
+	}
+
+	union := selector.Union()
+	for _, oneSelector := range union {
+		if app.isBytesSelector(oneSelector) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (app *application) selectorOnResult(selector selectors.Selector, result results.Result) ([]*span, error) {
 	if !result.Token().IsSuccess() {
 		return nil, errors.New("the selector cannot extract result tokens because the result is invalid")
 	}
@@ -38,177 +312,1074 @@ func (app *application) Execute(selector selectors.Selector, result results.Resu
 	return app.selectorOnToken(selector, token)
 }
 
-func (app *application) selectorOnToken(selector selectors.Selector, token results.Token) ([][]byte, error) {
+func (app *application) selectorOnToken(selector selectors.Selector, token results.Token) ([]*span, error) {
+	if selector.IsUnion() {
+		union := selector.Union()
+		return app.unionOnToken(union, token)
+	}
+
 	if selector.IsName() {
 		name := selector.Name()
 		return app.nameInsOnToken(name, token)
 	}
 
+	if selector.IsSibling() {
+		sibling := selector.Sibling()
+		return app.siblingOnToken(sibling, token)
+	}
+
+	if selector.IsAncestor() {
+		ancestor := selector.Ancestor()
+		return app.ancestorOnToken(ancestor, token)
+	}
+
+	if selector.IsBetween() {
+		between := selector.Between()
+		return app.betweenOnToken(between, token)
+	}
+
+	if selector.IsAll() {
+		all := selector.All()
+		return app.allOnToken(all, token)
+	}
+
+	if selector.IsChannel() {
+		channel := selector.Channel()
+		return app.channelOnToken(channel, token)
+	}
+
 	anyName := selector.Any()
 	return app.anyNameOnToken(anyName, token)
 }
 
-func (app *application) nameInsOnToken(nameIns selectors.Name, token results.Token) ([][]byte, error) {
-	name := nameIns.Name()
-	path := []string{}
-	if nameIns.HasInsideNames() {
-		path = nameIns.InsideNames()
+func (app *application) unionOnToken(union []selectors.Selector, token results.Token) ([]*span, error) {
+	spans := []*span{}
+	for _, oneSelector := range union {
+		retSpans, err := app.selectorOnToken(oneSelector, token)
+		if err != nil {
+			return nil, err
+		}
+
+		spans = append(spans, retSpans...)
+	}
+
+	sort.SliceStable(spans, func(i, j int) bool {
+		return spans[i].start < spans[j].start
+	})
+
+	output := []*span{}
+	for _, oneSpan := range spans {
+		isDuplicate := false
+		for _, oneOutput := range output {
+			if oneOutput.isSameAs(oneSpan) {
+				isDuplicate = true
+				break
+			}
+		}
+
+		if isDuplicate {
+			continue
+		}
+
+		output = append(output, oneSpan)
 	}
 
-	path = append(path, name)
-	bytes, _, err := app.nameOnToken(path, token)
+	return output, nil
+}
+
+func (app *application) nameInsOnToken(nameIns selectors.Name, token results.Token) ([]*span, error) {
+	nodes, err := app.nameInsNodesOnToken(nameIns, token)
 	if err != nil {
 		return nil, err
 	}
 
 	if nameIns.IsSelected() {
-		return bytes, nil
+		input := token.Block().Input()
+		return app.nameNodesToSpans(input, nodes, nameIns), nil
 	}
 
 	return nil, nil
 }
 
-func (app *application) nameOnToken(path []string, token results.Token) ([][]byte, bool, error) {
-	block := token.Block()
-	if !block.IsSuccess() {
-		str := fmt.Sprintf("the block's token (name: %s) is NOT successful and therefore its value cannot be extracted", token.Name())
-		return nil, false, errors.New(str)
+func (app *application) siblingOnToken(sibling selectors.Sibling, token results.Token) ([]*span, error) {
+	name := sibling.Name()
+	nodes, err := app.nameInsNodesOnToken(name, token)
+	if err != nil {
+		return nil, err
 	}
 
-	if len(path) <= 0 {
-		return nil, false, errors.New("the path is mandatory in order to retrieve the token's value, none provided")
+	if !name.IsSelected() {
+		return nil, nil
 	}
 
-	name := path[0]
-	currentPath := path
-	if token.Name() == name {
-		currentPath = path[1:]
+	var predicates []selectors.Predicate
+	target := sibling.Target()
+	if target.HasPredicates() {
+		predicates = target.Predicates()
 	}
 
-	isSameLine := false
-	output := [][]byte{}
-	lines := block.List()
-	for _, oneLine := range lines {
-		if !oneLine.IsSuccess() {
-			continue
-		}
-
-		elements := oneLine.Elements()
-		for _, oneElementWithCardinality := range elements {
-			if !oneElementWithCardinality.IsSuccess() {
+	output := []*node{}
+	for _, oneNode := range nodes {
+		matches := []*node{}
+		siblings := app.siblingNodes(oneNode, sibling.IsPreceding())
+		for _, oneSibling := range siblings {
+			if !app.isStepMatch(target, oneSibling.token.Name()) {
 				continue
 			}
 
-			if !oneElementWithCardinality.HasMatches() {
+			if !app.isPredicatesMatch(predicates, oneSibling.token, oneSibling.ancestors) {
 				continue
 			}
 
-			data := []byte{}
-			matches := oneElementWithCardinality.Matches()
-			for _, oneElement := range matches {
-				if oneElement.IsValue() {
-					if len(currentPath) > 0 {
-						continue
-					}
+			matches = append(matches, oneSibling)
+		}
 
-					pValue := oneElement.Value()
-					data = append(data, *pValue)
-					isSameLine = true
-					continue
-				}
+		if target.HasIndex() {
+			matches = app.indexNodes(target.Index(), matches)
+		}
 
-				if oneElement.IsToken() {
-					elementToken := oneElement.Token()
-					if len(currentPath) > 0 {
-						tokenBytes, tokenIsSameLine, err := app.nameOnToken(currentPath, elementToken)
-						if err != nil {
-							return nil, false, err
-						}
-
-						if len(tokenBytes) <= 0 {
-							continue
-						}
-
-						if tokenIsSameLine {
-							for _, oneLine := range tokenBytes {
-								data = append(data, oneLine...)
-							}
-
-							continue
-						}
-
-						output = append(output, tokenBytes...)
-						continue
-					}
-
-					elementBlock := elementToken.Block()
-					index := elementBlock.Discovered()
-					input := elementBlock.Input()
-					remaining := elementBlock.Remaining()
-					amount := len(input) - len(remaining)
-					data = append(data, input[index:amount]...)
-					isSameLine = true
-					continue
-				}
-			}
+		output = append(output, matches...)
+	}
+
+	output = app.sortNodes(app.uniqueNodes(output))
+	input := token.Block().Input()
+	return app.nameNodesToSpans(input, output, target), nil
+}
+
+func (app *application) ancestorOnToken(ancestor selectors.Ancestor, token results.Token) ([]*span, error) {
+	name := ancestor.Name()
+	nodes, err := app.nameInsNodesOnToken(name, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if !name.IsSelected() {
+		return nil, nil
+	}
 
-			if len(data) <= 0 {
+	output := []*node{}
+	for _, oneNode := range nodes {
+		// walk up the ancestors, from the nearest to the root:
+		for idx := len(oneNode.ancestors) - 1; idx >= 0; idx-- {
+			parent := createNode(oneNode.ancestors[idx], nil, oneNode.ancestors[:idx])
+			if ancestor.HasTarget() && !app.isAncestorMatch(ancestor.Target(), parent) {
 				continue
 			}
 
-			output = append(output, data)
+			output = append(output, parent)
+			break
 		}
 	}
 
-	return output, isSameLine, nil
+	output = app.sortNodes(app.uniqueNodes(output))
+	input := token.Block().Input()
+	return app.nodesToSpans(input, output), nil
 }
 
-func (app *application) anyNameOnToken(anyElement selectors.Name, token results.Token) ([][]byte, error) {
-	block := token.Block()
-	input := block.Input()
-	index := block.Discovered()
-	prefixes, err := app.nameInsOnToken(anyElement, token)
+func (app *application) betweenOnToken(between selectors.Between, token results.Token) ([]*span, error) {
+	from := between.From()
+	fromNodes, err := app.nameInsNodesOnToken(from, token)
 	if err != nil {
 		return nil, err
 	}
 
-	data := input[index:]
-	list := [][]byte{}
-	for _, onePrefix := range prefixes {
-		element := data
-		for idx := range data {
-			element = data[idx:]
-			if bytes.HasPrefix(element, onePrefix) {
+	if !from.IsSelected() {
+		return nil, nil
+	}
+
+	var predicates []selectors.Predicate
+	to := between.To()
+	if to.HasPredicates() {
+		predicates = to.Predicates()
+	}
+
+	toNodes, err := app.nameOnToken([]step{to}, predicates, token, nil, []results.Token{})
+	if err != nil {
+		return nil, err
+	}
+
+	toNodes = app.sortNodes(app.uniqueNodes(toNodes))
+	input := token.Block().Input()
+	output := []*span{}
+	for _, oneFromNode := range fromNodes {
+		fromStart, fromEnd := app.nodeOffsets(input, oneFromNode)
+		for _, oneToNode := range toNodes {
+			toStart, toEnd := app.nodeOffsets(input, oneToNode)
+			if toStart < fromEnd {
+				continue
+			}
+
+			if between.IsInclusive() {
+				output = append(output, createSpan(fromStart, toEnd, input[fromStart:toEnd], nil))
 				break
 			}
+
+			output = append(output, createSpan(fromEnd, toStart, input[fromEnd:toStart], nil))
+			break
+		}
+	}
+
+	return output, nil
+}
+
+func (app *application) allOnToken(all selectors.All, token results.Token) ([]*span, error) {
+	nodes := []*node{
+		createNode(token, nil, []results.Token{}),
+	}
+
+	if all.HasInsides() {
+		path := []step{}
+		insides := all.Insides()
+		for _, oneInside := range insides {
+			path = append(path, oneInside)
 		}
 
-		value := []byte{}
-		index := len(data) - len(element) + 1
-		if len(data) >= index {
-			value = data[index:]
+		retNodes, err := app.nameOnToken(path, nil, token, nil, []results.Token{})
+		if err != nil {
+			return nil, err
 		}
 
-		list = append(list, value)
-		data = value
+		nodes = app.uniqueNodes(retNodes)
 	}
 
-	return list, nil
+	input := token.Block().Input()
+	output := []*span{}
+	for _, oneNode := range nodes {
+		start, end := app.nodeOffsets(input, oneNode)
+		output = append(output, createSpan(start, end, input[start:end], []results.Token{
+			oneNode.token,
+		}))
+	}
+
+	return output, nil
+}
+
+func (app *application) channelOnToken(channel selectors.Channel, token results.Token) ([]*span, error) {
+	if app.grammar == nil || !app.grammar.HasChannels() {
+		return nil, errors.New("the channel selector requires an application created with a grammar that contains channels")
+	}
+
+	name := channel.Name()
+	nodes, err := app.nameInsNodesOnToken(name, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if !name.IsSelected() {
+		return nil, nil
+	}
+
+	channelGrammars, err := app.channelGrammars()
+	if err != nil {
+		return nil, err
+	}
+
+	var predicates []selectors.Predicate
+	target := channel.Target()
+	if target.HasPredicates() {
+		predicates = target.Predicates()
+	}
+
+	input := token.Block().Input()
+	values := app.valuePositions(input, token, make([]bool, len(input)))
+	gaps := map[int][]results.Token{}
+	output := []*node{}
+	for _, oneNode := range nodes {
+		// the channel bytes of a token are the bytes between its value bytes and the nearest value bytes around it:
+		start, end := app.nodeOffsets(input, oneNode)
+		before := start
+		for before > 0 && !values[before-1] {
+			before--
+		}
+
+		after := end
+		for after < len(input) && !values[after] {
+			after++
+		}
+
+		matches := []*node{}
+		for _, oneGap := range [][]int{{before, start}, {end, after}} {
+			channelTokens, ok := gaps[oneGap[0]]
+			if !ok {
+				channelTokens = app.channelTokens(channelGrammars, input, oneGap[0], oneGap[1])
+				gaps[oneGap[0]] = channelTokens
+			}
+
+			for _, oneChannelToken := range channelTokens {
+				retNodes, err := app.nameOnToken([]step{target}, predicates, oneChannelToken, nil, []results.Token{})
+				if err != nil {
+					return nil, err
+				}
+
+				matches = append(matches, retNodes...)
+			}
+		}
+
+		if target.HasIndex() {
+			matches = app.indexNodes(target.Index(), matches)
+		}
+
+		output = append(output, matches...)
+	}
+
+	output = app.sortNodes(app.uniqueNodes(output))
+	return app.nodesToSpans(input, output), nil
+}
+
+func (app *application) channelGrammars() ([]grammars.Grammar, error) {
+	output := []grammars.Grammar{}
+	channelsList := app.grammar.Channels().List()
+	for _, oneChannel := range channelsList {
+		ins, err := app.grammarBuilder.Create().WithRoot(oneChannel.Token()).Now()
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, ins)
+	}
+
+	return output, nil
+}
+
+// channelTokens tokenizes the channel bytes located between the from and to offsets, until no channel token matches
+func (app *application) channelTokens(channelGrammars []grammars.Grammar, input []byte, from int, to int) []results.Token {
+	output := []results.Token{}
+	position := from
+	for position < to {
+		channelToken := app.channelToken(channelGrammars, input[position:], to-position)
+		if channelToken == nil {
+			break
+		}
+
+		output = append(output, channelToken)
+		position = len(input) - len(channelToken.Block().Remaining())
+	}
+
+	return output
+}
+
+func (app *application) channelToken(channelGrammars []grammars.Grammar, data []byte, max int) results.Token {
+	for _, oneGrammar := range channelGrammars {
+		result, err := app.validatorApp.Execute(oneGrammar, data, false)
+		if err != nil {
+			continue
+		}
+
+		token := result.Token()
+		if !token.IsSuccess() {
+			continue
+		}
+
+		amount := len(data) - len(token.Block().Remaining())
+		if amount <= 0 || amount > max {
+			continue
+		}
+
+		return token
+	}
+
+	return nil
+}
+
+func (app *application) nodeOffsets(input []byte, current *node) (int, int) {
+	start := app.tokenStart(input, current.token)
+	end := len(input) - len(current.token.Block().Remaining())
+	return start, end
 }
 
-func (app *application) getIndexes(amount uint8, min uint8, pMax *uint8) (int, int) {
-	max := amount - 1
-	if pMax == nil {
-		return int(min), int(max)
+func (app *application) isAncestorMatch(target selectors.Name, current *node) bool {
+	if !app.isStepMatch(target, current.token.Name()) {
+		return false
+	}
+
+	if !target.HasPredicates() {
+		return true
+	}
+
+	return app.isPredicatesMatch(target.Predicates(), current.token, current.ancestors)
+}
+
+func (app *application) nameInsNodesOnToken(nameIns selectors.Name, token results.Token) ([]*node, error) {
+	path := []step{}
+	if nameIns.HasInsides() {
+		insides := nameIns.Insides()
+		for _, oneInside := range insides {
+			path = append(path, oneInside)
+		}
+	}
+
+	var predicates []selectors.Predicate
+	if nameIns.HasPredicates() {
+		predicates = nameIns.Predicates()
+	}
+
+	path = append(path, nameIns)
+	nodes, err := app.nameOnToken(path, predicates, token, nil, []results.Token{})
+	if err != nil {
+		return nil, err
+	}
+
+	nodes = app.uniqueNodes(nodes)
+	if nameIns.HasIndex() {
+		index := nameIns.Index()
+		return app.indexNodes(index, nodes), nil
+	}
+
+	return nodes, nil
+}
+
+func (app *application) nameOnToken(path []step, predicates []selectors.Predicate, token results.Token, element results.ElementWithCardinality, ancestors []results.Token) ([]*node, error) {
+	block := token.Block()
+	if !block.IsSuccess() {
+		str := fmt.Sprintf("the block's token (name: %s) is NOT successful and therefore its value cannot be extracted", token.Name())
+		return nil, errors.New(str)
+	}
+
+	if len(path) <= 0 {
+		return nil, errors.New("the path is mandatory in order to retrieve the token's value, none provided")
+	}
+
+	currentPath := path
+	if app.isStepMatch(path[0], token.Name()) {
+		currentPath = path[1:]
+	}
+
+	// the predicates only apply to the last step, and a token that fails them is not a match:
+	if len(currentPath) <= 0 && !app.isPredicatesMatch(predicates, token, ancestors) {
+		currentPath = path
+	}
+
+	// a direct child step that does not match its token cannot be matched deeper in the tree:
+	if len(currentPath) == len(path) && path[0].IsChild() {
+		return []*node{}, nil
+	}
+
+	if len(currentPath) <= 0 {
+		return []*node{
+			createNode(token, element, ancestors),
+		}, nil
+	}
+
+	// when the next step must be a direct child, only the children of the token can match it,
+	// but the current step can still be matched deeper in the tree if it is not a direct child itself:
+	isDeeper := len(currentPath) < len(path) && currentPath[0].IsChild() && !path[0].IsChild()
+	output := []*node{}
+	children := app.childNodes(token, ancestors)
+	for _, oneChild := range children {
+		nodes, err := app.nameOnToken(currentPath, predicates, oneChild.token, oneChild.element, oneChild.ancestors)
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, nodes...)
+		if !isDeeper {
+			continue
+		}
+
+		deeperNodes, err := app.nameOnToken(path, predicates, oneChild.token, oneChild.element, oneChild.ancestors)
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, deeperNodes...)
+	}
+
+	return output, nil
+}
+
+func (app *application) isPredicatesMatch(predicates []selectors.Predicate, token results.Token, ancestors []results.Token) bool {
+	for _, onePredicate := range predicates {
+		if onePredicate.IsNot() && app.isSelectorOnChain(onePredicate.Not(), token, ancestors) {
+			return false
+		}
+
+		if onePredicate.IsValue() && !app.isValueMatch(onePredicate.Value(), app.tokenContent(token)) {
+			return false
+		}
+
+		if onePredicate.IsAlternative() && app.tokenAlternative(token)+1 != *onePredicate.Alternative() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (app *application) tokenAlternative(token results.Token) uint {
+	block := token.Block()
+	if !block.HasMatch() {
+		return 0
+	}
+
+	return block.Match().Index()
+}
+
+func (app *application) isValueMatch(value selectors.Value, content []byte) bool {
+	if value.IsEqual() {
+		return bytes.Equal(content, value.Equal())
+	}
+
+	if value.IsPrefix() {
+		return bytes.HasPrefix(content, value.Prefix())
+	}
+
+	if value.IsContains() {
+		return bytes.Contains(content, value.Contains())
+	}
+
+	return value.Pattern().Match(content)
+}
+
+func (app *application) isSelectorOnChain(selector selectors.Selector, token results.Token, ancestors []results.Token) bool {
+	if selector.IsUnion() {
+		union := selector.Union()
+		for _, oneSelector := range union {
+			if app.isSelectorOnChain(oneSelector, token, ancestors) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if !selector.IsName() {
+		return false
+	}
+
+	name := selector.Name()
+	if !app.isStepMatch(name, token.Name()) {
+		return false
+	}
+
+	if name.HasPredicates() && !app.isPredicatesMatch(name.Predicates(), token, ancestors) {
+		return false
+	}
+
+	path := []step{}
+	if name.HasInsides() {
+		insides := name.Insides()
+		for _, oneInside := range insides {
+			path = append(path, oneInside)
+		}
+	}
+
+	return app.isPathOnAncestors(path, ancestors, name.IsChild())
+}
+
+// isPathOnAncestors matches the path from its end, against the ancestors of the token matched by the step following the path:
+func (app *application) isPathOnAncestors(path []step, ancestors []results.Token, isChild bool) bool {
+	if len(path) <= 0 {
+		return true
+	}
+
+	last := path[len(path)-1]
+	for i := len(ancestors) - 1; i >= 0; i-- {
+		if app.isStepMatch(last, ancestors[i].Name()) && app.isPathOnAncestors(path[:len(path)-1], ancestors[:i], last.IsChild()) {
+			return true
+		}
+
+		if isChild {
+			break
+		}
+	}
+
+	return false
+}
+
+func (app *application) isStepMatch(step step, name string) bool {
+	if step.HasPattern() {
+		return step.Pattern().MatchString(name)
+	}
+
+	if step.IsLiteral() {
+		return step.Name() == name
+	}
+
+	return app.isNameMatch(step.Name(), name)
+}
+
+func (app *application) isNameMatch(pattern string, name string) bool {
+	alternatives := app.expandAlternatives([]byte(pattern))
+	for _, oneAlternative := range alternatives {
+		if app.isWildcardMatch(oneAlternative, []byte(name)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (app *application) expandAlternatives(pattern []byte) [][]byte {
+	start := -1
+	end := -1
+	last := 0
+	depth := 0
+	options := [][]byte{}
+	for idx, oneByte := range pattern {
+		if oneByte == app.alternativesPrefix {
+			if depth == 0 {
+				start = idx
+				last = idx + 1
+			}
+
+			depth++
+			continue
+		}
+
+		if depth == 1 && oneByte == app.alternativesDelimiter {
+			options = append(options, pattern[last:idx])
+			last = idx + 1
+			continue
+		}
+
+		if depth > 0 && oneByte == app.alternativesSuffix {
+			depth--
+			if depth == 0 {
+				options = append(options, pattern[last:idx])
+				end = idx
+				break
+			}
+		}
+	}
+
+	if end < 0 {
+		return [][]byte{
+			pattern,
+		}
+	}
+
+	output := [][]byte{}
+	for _, oneOption := range options {
+		expanded := []byte{}
+		expanded = append(expanded, pattern[:start]...)
+		expanded = append(expanded, oneOption...)
+		expanded = append(expanded, pattern[end+1:]...)
+		output = append(output, app.expandAlternatives(expanded)...)
+	}
+
+	return output
+}
+
+func (app *application) isWildcardMatch(pattern []byte, name []byte) bool {
+	if len(pattern) <= 0 {
+		return len(name) <= 0
+	}
+
+	if pattern[0] == app.wildcardByte {
+		for idx := 0; idx <= len(name); idx++ {
+			if app.isWildcardMatch(pattern[1:], name[idx:]) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if len(name) <= 0 {
+		return false
+	}
+
+	if pattern[0] == app.singleWildcardByte || pattern[0] == name[0] {
+		return app.isWildcardMatch(pattern[1:], name[1:])
+	}
+
+	return false
+}
+
+func (app *application) childNodes(token results.Token, ancestors []results.Token) []*node {
+	output := []*node{}
+	lines := app.matchedLines(token)
+	for _, oneLine := range lines {
+		output = append(output, app.lineNodes(token, ancestors, oneLine)...)
+	}
+
+	return output
+}
+
+// matchedLines returns the line that matched the block of the token, the other successful lines of the block are not part of the result
+func (app *application) matchedLines(token results.Token) []results.Line {
+	block := token.Block()
+	if !block.HasMatch() {
+		return []results.Line{}
+	}
+
+	return []results.Line{
+		block.Match(),
+	}
+}
+
+func (app *application) lineNodes(token results.Token, ancestors []results.Token, line results.Line) []*node {
+	output := []*node{}
+	if !line.IsSuccess() {
+		return output
+	}
+
+	childAncestors := append(append([]results.Token{}, ancestors...), token)
+	elements := line.Elements()
+	for _, oneElementWithCardinality := range elements {
+		if !oneElementWithCardinality.IsSuccess() {
+			continue
+		}
+
+		if !oneElementWithCardinality.HasMatches() {
+			continue
+		}
+
+		matches := oneElementWithCardinality.Matches()
+		for _, oneElement := range matches {
+			if !oneElement.IsToken() {
+				continue
+			}
+
+			output = append(output, createNode(oneElement.Token(), oneElementWithCardinality, childAncestors))
+		}
+	}
+
+	return output
+}
+
+func (app *application) siblingNodes(current *node, isPreceding bool) []*node {
+	if len(current.ancestors) <= 0 {
+		return []*node{}
+	}
+
+	parent := current.ancestors[len(current.ancestors)-1]
+	lines := app.matchedLines(parent)
+	for _, oneLine := range lines {
+		nodes := app.lineNodes(parent, current.ancestors[:len(current.ancestors)-1], oneLine)
+		for idx, oneNode := range nodes {
+			if oneNode.token != current.token {
+				continue
+			}
+
+			if isPreceding {
+				return nodes[:idx]
+			}
+
+			return nodes[idx+1:]
+		}
+	}
+
+	return []*node{}
+}
+
+func (app *application) sortNodes(nodes []*node) []*node {
+	// the input of a token is the remaining data from its start, so a longer input starts earlier:
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return len(nodes[i].token.Block().Input()) > len(nodes[j].token.Block().Input())
+	})
+
+	return nodes
+}
+
+func (app *application) uniqueNodes(nodes []*node) []*node {
+	output := []*node{}
+	for _, oneNode := range nodes {
+		isDuplicate := false
+		for _, oneOutput := range output {
+			if oneOutput.token == oneNode.token {
+				isDuplicate = true
+				break
+			}
+		}
+
+		if isDuplicate {
+			continue
+		}
+
+		output = append(output, oneNode)
+	}
+
+	return output
+}
+
+func (app *application) indexNodes(index selectors.Index, nodes []*node) []*node {
+	amount := len(nodes)
+	if index.IsPosition() {
+		position := *index.Position()
+		if position < 0 {
+			position += amount
+		}
+
+		if position < 0 || position >= amount {
+			return []*node{}
+		}
+
+		return nodes[position : position+1]
+	}
+
+	slice := index.Slice()
+	from, to := app.getIndexes(amount, slice.From(), slice.To())
+	return nodes[from:to]
+}
+
+func (app *application) nameNodesToSpans(input []byte, nodes []*node, name selectors.Name) []*span {
+	if !name.HasRepetition() {
+		// the tokens filtered by an index or predicates are returned one by one, as they were filtered:
+		if name.HasIndex() || name.HasPredicates() {
+			return app.nodesToSingleSpans(input, nodes)
+		}
+
+		return app.nodesToSpans(input, nodes)
+	}
+
+	output := []*span{}
+	repetition := name.Repetition()
+	groups := app.repetitionGroups(nodes)
+	for _, oneGroup := range groups {
+		if repetition.IsAmount() {
+			tokens := []results.Token{}
+			for _, oneNode := range oneGroup {
+				tokens = append(tokens, oneNode.token)
+			}
+
+			start, _ := app.nodeOffsets(input, oneGroup[0])
+			_, end := app.nodeOffsets(input, oneGroup[len(oneGroup)-1])
+			amount := []byte(strconv.Itoa(len(oneGroup)))
+			output = append(output, createSpanWithCount(start, end, amount, tokens))
+			continue
+		}
+
+		selected := oneGroup
+		if repetition.IsIndex() {
+			selected = app.indexNodes(repetition.Index(), oneGroup)
+		}
+
+		output = append(output, app.nodesToSingleSpans(input, selected)...)
+	}
+
+	return output
+}
+
+func (app *application) nodesToSingleSpans(input []byte, nodes []*node) []*span {
+	output := []*span{}
+	for _, oneNode := range nodes {
+		start, end := app.nodeOffsets(input, oneNode)
+		output = append(output, createSpan(start, end, app.tokenContent(oneNode.token), []results.Token{
+			oneNode.token,
+		}))
+	}
+
+	return output
+}
+
+func (app *application) repetitionGroups(nodes []*node) [][]*node {
+	output := [][]*node{}
+	var previous *node
+	for _, oneNode := range nodes {
+		if previous != nil && previous.isRepetitionOf(oneNode) {
+			output[len(output)-1] = append(output[len(output)-1], oneNode)
+			previous = oneNode
+			continue
+		}
+
+		output = append(output, []*node{
+			oneNode,
+		})
+
+		previous = oneNode
+	}
+
+	return output
+}
+
+func (app *application) nodesToSpans(input []byte, nodes []*node) []*span {
+	output := []*span{}
+	var previous *node
+	for _, oneNode := range nodes {
+		start, end := app.nodeOffsets(input, oneNode)
+		value := app.tokenContent(oneNode.token)
+		if previous != nil && previous.isRepetitionOf(oneNode) {
+			last := output[len(output)-1]
+			last.data = append(last.data, value...)
+			last.end = end
+			last.tokens = append(last.tokens, oneNode.token)
+			previous = oneNode
+			continue
+		}
+
+		output = append(output, createSpan(start, end, value, []results.Token{
+			oneNode.token,
+		}))
+		previous = oneNode
+	}
+
+	return output
+}
+
+// tokenStart returns the offset of the first value byte of the token, because the input of a block
+// can still begin with channel bytes that were not consumed before it
+func (app *application) tokenStart(input []byte, token results.Token) int {
+	block := token.Block()
+	start := len(input) - len(block.Input())
+	if !block.HasMatch() {
+		return start
+	}
+
+	elements := block.Match().Elements()
+	for _, oneElementWithCardinality := range elements {
+		if !oneElementWithCardinality.HasMatches() {
+			continue
+		}
+
+		matches := oneElementWithCardinality.Matches()
+		if matches[0].IsToken() {
+			return app.tokenStart(input, matches[0].Token())
+		}
+
+		// the value bytes of an element are contiguous and precede its remaining data:
+		return len(input) - len(oneElementWithCardinality.Remaining()) - len(matches)
+	}
+
+	return start
+}
+
+func (app *application) tokenContent(token results.Token) []byte {
+	data := []byte{}
+	lines := app.matchedLines(token)
+	for _, oneLine := range lines {
+		if !oneLine.IsSuccess() {
+			continue
+		}
+
+		elements := oneLine.Elements()
+		for _, oneElementWithCardinality := range elements {
+			if !oneElementWithCardinality.IsSuccess() {
+				continue
+			}
+
+			if !oneElementWithCardinality.HasMatches() {
+				continue
+			}
+
+			matches := oneElementWithCardinality.Matches()
+			for _, oneElement := range matches {
+				if oneElement.IsValue() {
+					pValue := oneElement.Value()
+					data = append(data, *pValue)
+					continue
+				}
+
+				elementBlock := oneElement.Token().Block()
+				index := elementBlock.Discovered()
+				input := elementBlock.Input()
+				remaining := elementBlock.Remaining()
+				amount := len(input) - len(remaining)
+				data = append(data, input[index:amount]...)
+			}
+		}
+	}
+
+	return data
+}
+
+// normalizedContent returns the value bytes located between the start and end offsets, skipping the channel bytes
+func (app *application) normalizedContent(input []byte, values []bool, start int, end int) []byte {
+	data := []byte{}
+	for idx := start; idx < end; idx++ {
+		if !values[idx] {
+			continue
+		}
+
+		data = append(data, input[idx])
+	}
+
+	return data
+}
+
+// valuePositions flags the offsets of the value bytes of the token, every other byte of its input is a channel byte
+func (app *application) valuePositions(input []byte, token results.Token, values []bool) []bool {
+	lines := app.matchedLines(token)
+	for _, oneLine := range lines {
+		if !oneLine.IsSuccess() {
+			continue
+		}
+
+		elements := oneLine.Elements()
+		for _, oneElementWithCardinality := range elements {
+			if !oneElementWithCardinality.IsSuccess() {
+				continue
+			}
+
+			if !oneElementWithCardinality.HasMatches() {
+				continue
+			}
+
+			// the value bytes of an element are contiguous and precede its remaining data:
+			matches := oneElementWithCardinality.Matches()
+			elementEnd := len(input) - len(oneElementWithCardinality.Remaining())
+			elementStart := elementEnd - len(matches)
+			for idx, oneElement := range matches {
+				if oneElement.IsValue() {
+					values[elementStart+idx] = true
+					continue
+				}
+
+				values = app.valuePositions(input, oneElement.Token(), values)
+			}
+		}
+	}
+
+	return values
+}
+
+func (app *application) anyNameOnToken(anyElement selectors.Name, token results.Token) ([]*span, error) {
+	prefixes, err := app.nameInsOnToken(anyElement, token)
+	if err != nil {
+		return nil, err
+	}
+
+	// the suffix starts at the end offset of its prefix token, and ends with the root token:
+	block := token.Block()
+	input := block.Input()
+	end := len(input) - len(block.Remaining())
+	list := []*span{}
+	for _, onePrefix := range prefixes {
+		start := onePrefix.end
+		if start > end {
+			start = end
+		}
+
+		list = append(list, createSpan(start, end, input[start:end], nil))
+	}
+
+	return list, nil
+}
+
+func (app *application) getIndexes(amount int, pFrom *int, pTo *int) (int, int) {
+	min := 0
+	if pFrom != nil {
+		min = *pFrom
+	}
+
+	max := amount
+	if pTo != nil {
+		max = *pTo
+	}
+
+	if min < 0 {
+		min += amount
+	}
+
+	if max < 0 {
+		max += amount
+	}
+
+	if min < 0 {
+		min = 0
+	}
+
+	if min > amount {
+		min = amount
 	}
 
-	if max > *pMax {
-		max = *pMax
+	if max > amount {
+		max = amount
 	}
 
 	if max < min {
 		max = min
 	}
 
-	return int(min), int(max)
+	return min, max
 }
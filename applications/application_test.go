@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"testing"
 
+	"github.com/steve-care-software/selector/domain/selectors"
 	validator "github.com/steve-care-software/validator/applications"
 )
 
@@ -438,3 +439,2409 @@ func TestSelector_withByte_isSuccess(t *testing.T) {
 		}
 	}
 }
+
+func TestSelector_withIndex_isSuccess(t *testing.T) {
+	schema := `
+		%rootToken;
+		-space;
+		-endOfLine;
+
+		rootToken: .bytes
+				 | .byte
+				 ;
+
+		bytes: .openSquareBracket .byteWithSemiColon[1,] .closeSquareBracket;
+		byteWithSemiColon: .byte .semiColon;
+		byte: .dollar .number[1,3];
+
+		number: .zero
+			  | .one
+			  | .two
+			  | .three
+			  | .four
+			  | .five
+			  | .six
+			  | .seven
+			  | .height
+			  | .nine
+			  ;
+
+		openSquareBracket: $91;
+		closeSquareBracket: $93;
+		semiColon: $59;
+		dollar: $36;
+		zero: $48;
+		one: $49;
+		two: $50;
+		three: $51;
+		four: $52;
+		five: $53;
+		six: $54;
+		seven: $55;
+		height: $56;
+		nine: $57;
+		space: $32;
+		endOfLine: $10;
+	`
+
+	data := []byte("[$100; $20; $30; $45;]")
+	validatorApp := validator.NewApplication()
+	validator, err := validatorApp.Compile(schema)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	result, err := validatorApp.Execute(validator, data, true)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	selectors := map[string][][]byte{
+		"+ @byteWithSemiColon .byte[0]": {
+			[]byte("$100"),
+		},
+		"+ @byteWithSemiColon .byte[1]": {
+			[]byte("$20"),
+		},
+		"+ @byteWithSemiColon .byte[-1]": {
+			[]byte("$45"),
+		},
+		"+ @byteWithSemiColon .byte[1:3]": {
+			[]byte("$20"),
+			[]byte("$30"),
+		},
+		"+ @byteWithSemiColon .byte[2:]": {
+			[]byte("$30"),
+			[]byte("$45"),
+		},
+		"+ @byteWithSemiColon .byte[:-3]": {
+			[]byte("$100"),
+		},
+		"+ @byteWithSemiColon .byte[4]":  {},
+		"+ @byteWithSemiColon .byte[-5]": {},
+		"+ @byteWithSemiColon .byte[5:]": {},
+		"+ @byte .number[1]": {
+			[]byte("0"),
+		},
+		"+ @byte .number": {
+			[]byte("100"),
+			[]byte("20"),
+			[]byte("30"),
+			[]byte("45"),
+		},
+		"+ @byte .number[1:3]": {
+			[]byte("0"),
+			[]byte("0"),
+		},
+	}
+
+	application := NewApplication()
+	for selector, expected := range selectors {
+		selectorIns, _, err := application.Compile(selector)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retBytes, err := application.Execute(selectorIns, result)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if len(retBytes) != len(expected) {
+			t.Errorf("%d elements were expected, %d returned (selector: %s)", len(expected), len(retBytes), selector)
+			return
+		}
+
+		for idx, data := range retBytes {
+			if bytes.Compare(data, expected[idx]) != 0 {
+				t.Errorf("%v bytes  were expected, %v returned at index: %d (selector: %s)", expected[idx], data, idx, selector)
+				return
+			}
+		}
+	}
+}
+
+func TestSelector_withUnion_isSuccess(t *testing.T) {
+	schema := `
+		%rootToken;
+		-space;
+		-endOfLine;
+
+		rootToken : .five .smallerThan .five
+				  ;
+
+		openParenthesis: $40;
+		closeParenthesis: $41;
+		five: $53;
+		smallerThan: $60;
+		space: $32;
+		endOfLine: $10;
+	`
+
+	data := []byte("5 < 5")
+	validatorApp := validator.NewApplication()
+	validator, err := validatorApp.Compile(schema)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	result, err := validatorApp.Execute(validator, data, true)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	selectors := map[string][][]byte{
+		"+ .five , + .smallerThan": {
+			[]byte("5"),
+			[]byte("<"),
+			[]byte("5"),
+		},
+		"+ .five[1] , + .smallerThan , + .five[0]": {
+			[]byte("5"),
+			[]byte("<"),
+			[]byte("5"),
+		},
+		"+ .five , + @rootToken .five": {
+			[]byte("5"),
+			[]byte("5"),
+		},
+		"+ .smallerThan , .five": {
+			[]byte("<"),
+		},
+	}
+
+	application := NewApplication()
+	for selector, expected := range selectors {
+		selectorIns, _, err := application.Compile(selector)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retBytes, err := application.Execute(selectorIns, result)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if len(retBytes) != len(expected) {
+			t.Errorf("%d elements were expected, %d returned (selector: %s)", len(expected), len(retBytes), selector)
+			return
+		}
+
+		for idx, data := range retBytes {
+			if bytes.Compare(data, expected[idx]) != 0 {
+				t.Errorf("%v bytes  were expected, %v returned at index: %d (selector: %s)", expected[idx], data, idx, selector)
+				return
+			}
+		}
+	}
+}
+
+func TestSelector_withStatements_isSuccess(t *testing.T) {
+	schema := `
+		%rootToken;
+		-space;
+		-endOfLine;
+
+		rootToken : .five .smallerThan .five
+				  ;
+
+		openParenthesis: $40;
+		closeParenthesis: $41;
+		five: $53;
+		smallerThan: $60;
+		space: $32;
+		endOfLine: $10;
+	`
+
+	data := []byte("5 < 5")
+	validatorApp := validator.NewApplication()
+	validator, err := validatorApp.Compile(schema)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	result, err := validatorApp.Execute(validator, data, true)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	script := `
+		lhs = + @rootToken .five[0];
+		op = + .smallerThan;
+		rhs = + @rootToken .five[-1] *;
+	`
+
+	application := NewApplication()
+	statements, _, err := application.CompileStatements(script)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	outputs, err := application.ExecuteStatements(statements, result)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expectedNames := []string{
+		"lhs",
+		"op",
+		"rhs",
+	}
+
+	list := outputs.List()
+	if len(list) != len(expectedNames) {
+		t.Errorf("%d outputs were expected, %d returned", len(expectedNames), len(list))
+		return
+	}
+
+	for idx, oneOutput := range list {
+		if oneOutput.Name() != expectedNames[idx] {
+			t.Errorf("the output at index %d was expected to be named '%s', '%s' returned", idx, expectedNames[idx], oneOutput.Name())
+			return
+		}
+	}
+
+	op, err := outputs.Fetch("op")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	values := op.Values()
+	if len(values) != 1 || bytes.Compare(values[0], []byte("<")) != 0 {
+		t.Errorf("the op output was expected to contain the smallerThan bytes, %v returned", values)
+		return
+	}
+
+	_, err = outputs.Fetch("invalid")
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestSelector_withDirectChild_isSuccess(t *testing.T) {
+	schema := `
+		%rootToken;
+		-space;
+		-endOfLine;
+
+		rootToken : .five .smallerThan .group
+				  ;
+
+		group: .openParenthesis .five .closeParenthesis;
+		openParenthesis: $40;
+		closeParenthesis: $41;
+		five: $53;
+		smallerThan: $60;
+		space: $32;
+		endOfLine: $10;
+	`
+
+	data := []byte("5 < ( 5 )")
+	validatorApp := validator.NewApplication()
+	validator, err := validatorApp.Compile(schema)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	result, err := validatorApp.Execute(validator, data, true)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	selectors := map[string][][]byte{
+		"+ @rootToken .five": {
+			[]byte("5"),
+			[]byte("5"),
+		},
+		"+ @rootToken > .five": {
+			[]byte("5"),
+		},
+		"+ @rootToken > .group": {
+			[]byte("(5)"),
+		},
+		"+ @rootToken > @group > .five": {
+			[]byte("5"),
+		},
+		"+ @rootToken @group > .five": {
+			[]byte("5"),
+		},
+		"+ @rootToken > @five .group": {},
+		"+ @group > .rootToken":       {},
+	}
+
+	application := NewApplication()
+	for selector, expected := range selectors {
+		selectorIns, _, err := application.Compile(selector)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retBytes, err := application.Execute(selectorIns, result)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if len(retBytes) != len(expected) {
+			t.Errorf("%d elements were expected, %d returned (selector: %s)", len(expected), len(retBytes), selector)
+			return
+		}
+
+		for idx, data := range retBytes {
+			if bytes.Compare(data, expected[idx]) != 0 {
+				t.Errorf("%v bytes  were expected, %v returned at index: %d (selector: %s)", expected[idx], data, idx, selector)
+				return
+			}
+		}
+	}
+}
+
+func TestSelector_withDirectChild_withRecursiveToken_isSuccess(t *testing.T) {
+	schema := `
+		%rootToken;
+		-space;
+		-endOfLine;
+
+		rootToken : .openParenthesis .rootToken .closeParenthesis
+				  | .five .smallerThan .five
+				  ;
+
+		openParenthesis: $40;
+		closeParenthesis: $41;
+		five: $53;
+		smallerThan: $60;
+		space: $32;
+		endOfLine: $10;
+	`
+
+	data := []byte("(( 5 < 5 ))")
+	validatorApp := validator.NewApplication()
+	validator, err := validatorApp.Compile(schema)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	result, err := validatorApp.Execute(validator, data, true)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	selector := `
+		+ @rootToken > .rootToken
+	`
+
+	application := NewApplication()
+	selectorIns, _, err := application.Compile(selector)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retBytes, err := application.Execute(selectorIns, result)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := [][]byte{
+		[]byte("(5 < 5)"),
+		[]byte("5<5"),
+	}
+
+	if len(retBytes) != len(expected) {
+		t.Errorf("%d elements were expected, %d returned", len(expected), len(retBytes))
+		return
+	}
+
+	for idx, data := range retBytes {
+		if bytes.Compare(data, expected[idx]) != 0 {
+			t.Errorf("%v bytes  were expected, %v returned at index: %d", expected[idx], data, idx)
+			return
+		}
+	}
+}
+
+func TestSelector_withWildcards_isSuccess(t *testing.T) {
+	schema := `
+		%rootToken;
+		-space;
+		-endOfLine;
+
+		rootToken : .five .smallerThan .five
+				  ;
+
+		openParenthesis: $40;
+		closeParenthesis: $41;
+		five: $53;
+		smallerThan: $60;
+		space: $32;
+		endOfLine: $10;
+	`
+
+	data := []byte("5 < 5")
+	validatorApp := validator.NewApplication()
+	validator, err := validatorApp.Compile(schema)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	result, err := validatorApp.Execute(validator, data, true)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	selectors := map[string][][]byte{
+		"+ @rootToken .*": {
+			[]byte("5"),
+			[]byte("<"),
+			[]byte("5"),
+		},
+		"+ @rootToken .{five,smallerThan}": {
+			[]byte("5"),
+			[]byte("<"),
+			[]byte("5"),
+		},
+		"+ @rootToken .small*": {
+			[]byte("<"),
+		},
+		"+ @root* .f?ve": {
+			[]byte("5"),
+			[]byte("5"),
+		},
+		"+ @* .*Than": {
+			[]byte("<"),
+		},
+		"+ @rootToken .{six,seven}": {},
+		"+ @rootToken .fiv":         {},
+		"+ @rootToken .small* *": {
+			[]byte(" 5"),
+		},
+		`+ @rootToken ."small*"`: {},
+		`+ @"root*" .five`:       {},
+		`+ @"rootToken" ."five"`: {
+			[]byte("5"),
+			[]byte("5"),
+		},
+	}
+
+	application := NewApplication()
+	for selector, expected := range selectors {
+		selectorIns, _, err := application.Compile(selector)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retBytes, err := application.Execute(selectorIns, result)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if len(retBytes) != len(expected) {
+			t.Errorf("%d elements were expected, %d returned (selector: %s)", len(expected), len(retBytes), selector)
+			return
+		}
+
+		for idx, data := range retBytes {
+			if bytes.Compare(data, expected[idx]) != 0 {
+				t.Errorf("%v bytes  were expected, %v returned at index: %d (selector: %s)", expected[idx], data, idx, selector)
+				return
+			}
+		}
+	}
+}
+
+func TestSelector_withPattern_isSuccess(t *testing.T) {
+	schema := `
+		%rootToken;
+		-space;
+		-endOfLine;
+
+		rootToken : .five .smallerThan .five
+				  ;
+
+		openParenthesis: $40;
+		closeParenthesis: $41;
+		five: $53;
+		smallerThan: $60;
+		space: $32;
+		endOfLine: $10;
+	`
+
+	data := []byte("5 < 5")
+	validatorApp := validator.NewApplication()
+	validator, err := validatorApp.Compile(schema)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	result, err := validatorApp.Execute(validator, data, true)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	selectors := map[string][][]byte{
+		"+ @rootToken ./^(five|smallerThan)$/": {
+			[]byte("5"),
+			[]byte("<"),
+			[]byte("5"),
+		},
+		"+ @/^root/ ./Than$/": {
+			[]byte("<"),
+		},
+		"+ @rootToken ./^f/[-1]": {
+			[]byte("5"),
+		},
+		"+ @rootToken ./^six$/": {},
+	}
+
+	application := NewApplication()
+	for selector, expected := range selectors {
+		selectorIns, _, err := application.Compile(selector)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retBytes, err := application.Execute(selectorIns, result)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if len(retBytes) != len(expected) {
+			t.Errorf("%d elements were expected, %d returned (selector: %s)", len(expected), len(retBytes), selector)
+			return
+		}
+
+		for idx, data := range retBytes {
+			if bytes.Compare(data, expected[idx]) != 0 {
+				t.Errorf("%v bytes  were expected, %v returned at index: %d (selector: %s)", expected[idx], data, idx, selector)
+				return
+			}
+		}
+	}
+}
+
+func TestSelector_withNotPredicate_isSuccess(t *testing.T) {
+	schema := `
+		%rootToken;
+		-space;
+		-endOfLine;
+
+		rootToken : .openParenthesis .rootToken .closeParenthesis
+				  | .five .smallerThan .five
+				  ;
+
+		openParenthesis: $40;
+		closeParenthesis: $41;
+		five: $53;
+		smallerThan: $60;
+		space: $32;
+		endOfLine: $10;
+	`
+
+	data := []byte("(( 5 < 5 ))")
+	validatorApp := validator.NewApplication()
+	validator, err := validatorApp.Compile(schema)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	result, err := validatorApp.Execute(validator, data, true)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	selectors := map[string][][]byte{
+		"+ @rootToken .*:not(.five , .rootToken)": {
+			[]byte("("),
+			[]byte("("),
+			[]byte("<"),
+			[]byte(")"),
+			[]byte(")"),
+		},
+		"+ @rootToken .rootToken:not(@rootToken @rootToken .rootToken)": {
+			[]byte("(5 < 5)"),
+		},
+		"+ @rootToken .rootToken:not(@openParenthesis .rootToken)[0]": {
+			[]byte("(5 < 5)"),
+		},
+		"+ @rootToken .rootToken:not(@rootToken > .rootToken)": {},
+		"+ @rootToken .rootToken:not(@rootToken > .rootToken:not(@rootToken @rootToken .rootToken))": {
+			[]byte("5<5"),
+		},
+	}
+
+	application := NewApplication()
+	for selector, expected := range selectors {
+		selectorIns, _, err := application.Compile(selector)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retBytes, err := application.Execute(selectorIns, result)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if len(retBytes) != len(expected) {
+			t.Errorf("%d elements were expected, %d returned (selector: %s)", len(expected), len(retBytes), selector)
+			return
+		}
+
+		for idx, data := range retBytes {
+			if bytes.Compare(data, expected[idx]) != 0 {
+				t.Errorf("%v bytes  were expected, %v returned at index: %d (selector: %s)", expected[idx], data, idx, selector)
+				return
+			}
+		}
+	}
+}
+
+func TestSelector_withValuePredicate_isSuccess(t *testing.T) {
+	schema := `
+		%rootToken;
+		-space;
+		-endOfLine;
+
+		rootToken: .bytes
+				 | .byte
+				 ;
+
+		bytes: .openSquareBracket .byteWithSemiColon[1,] .closeSquareBracket;
+		byteWithSemiColon: .byte .semiColon;
+		byte: .dollar .number[1,3];
+
+		number: .zero
+			  | .one
+			  | .two
+			  | .three
+			  | .four
+			  | .five
+			  | .six
+			  | .seven
+			  | .height
+			  | .nine
+			  ;
+
+		openSquareBracket: $91;
+		closeSquareBracket: $93;
+		semiColon: $59;
+		dollar: $36;
+		zero: $48;
+		one: $49;
+		two: $50;
+		three: $51;
+		four: $52;
+		five: $53;
+		six: $54;
+		seven: $55;
+		height: $56;
+		nine: $57;
+		space: $32;
+		endOfLine: $10;
+	`
+
+	data := []byte("[$100; $20; $30; $203;]")
+	validatorApp := validator.NewApplication()
+	validator, err := validatorApp.Compile(schema)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	result, err := validatorApp.Execute(validator, data, true)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	selectors := map[string][][]byte{
+		`+ @bytes .byte[="$20"]`: {
+			[]byte("$20"),
+		},
+		`+ @bytes .byte[^="$2"]`: {
+			[]byte("$20"),
+			[]byte("$203"),
+		},
+		`+ @bytes .byte[ *= "0" ]`: {
+			[]byte("$100"),
+			[]byte("$20"),
+			[]byte("$30"),
+			[]byte("$203"),
+		},
+		`+ @bytes .byte[~=/^\$\d{2}$/]`: {
+			[]byte("$20"),
+			[]byte("$30"),
+		},
+		`+ @bytes .byte[^="$2"][-1]`: {
+			[]byte("$203"),
+		},
+		`+ @bytes .byteWithSemiColon:not(.byteWithSemiColon[*="0;"])`: {
+			[]byte("$203;"),
+		},
+		`+ @bytes .number[="1"]`: {
+			[]byte("1"),
+		},
+		`+ @bytes .number[="0"]`: {
+			[]byte("0"),
+			[]byte("0"),
+			[]byte("0"),
+			[]byte("0"),
+			[]byte("0"),
+		},
+		`+ @bytes .number[="100"]`: {},
+		`+ @bytes .number[~=/\d/][1:3]`: {
+			[]byte("0"),
+			[]byte("0"),
+		},
+		`+ @bytes .byte[="$2"]`: {},
+	}
+
+	application := NewApplication()
+	for selector, expected := range selectors {
+		selectorIns, _, err := application.Compile(selector)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retBytes, err := application.Execute(selectorIns, result)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if len(retBytes) != len(expected) {
+			t.Errorf("%d elements were expected, %d returned (selector: %s)", len(expected), len(retBytes), selector)
+			return
+		}
+
+		for idx, data := range retBytes {
+			if bytes.Compare(data, expected[idx]) != 0 {
+				t.Errorf("%v bytes  were expected, %v returned at index: %d (selector: %s)", expected[idx], data, idx, selector)
+				return
+			}
+		}
+	}
+}
+
+func TestSelector_executeMatches_withPrefix_isSuccess(t *testing.T) {
+	schema := `
+		%rootToken;
+		-space;
+		-endOfLine;
+
+		rootToken : .five .smallerThan .five
+				  ;
+
+		five: $53;
+		smallerThan: $60;
+		space: $32;
+		endOfLine: $10;
+	`
+
+	data := []byte("ab5 < 5")
+	validatorApp := validator.NewApplication()
+	validator, err := validatorApp.Compile(schema)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	result, err := validatorApp.Execute(validator, data, true)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	application := NewApplication()
+	selectorIns, _, err := application.Compile("+ @rootToken .five , + @rootToken .smallerThan")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	matches, err := application.ExecuteMatches(selectorIns, result)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := []struct {
+		start uint
+		end   uint
+		name  string
+	}{
+		{start: 2, end: 3, name: "five"},
+		{start: 4, end: 5, name: "smallerThan"},
+		{start: 6, end: 7, name: "five"},
+	}
+
+	if len(matches) != len(expected) {
+		t.Errorf("%d matches were expected, %d returned", len(expected), len(matches))
+		return
+	}
+
+	for idx, oneMatch := range matches {
+		if oneMatch.Start() != expected[idx].start || oneMatch.End() != expected[idx].end {
+			t.Errorf("the match (index: %d) was expected to cover [%d, %d), [%d, %d) returned", idx, expected[idx].start, expected[idx].end, oneMatch.Start(), oneMatch.End())
+			return
+		}
+
+		if oneMatch.Name() != expected[idx].name {
+			t.Errorf("the match (index: %d) was expected to be named '%s', '%s' returned", idx, expected[idx].name, oneMatch.Name())
+			return
+		}
+
+		path := oneMatch.Path()
+		if len(path) <= 0 || path[0] != expected[idx].name {
+			t.Errorf("the match (index: %d) was expected to have a path starting with '%s', %v returned", idx, expected[idx].name, path)
+			return
+		}
+
+		if !bytes.Equal(data[oneMatch.Start():oneMatch.End()], oneMatch.Data()) {
+			t.Errorf("the match (index: %d) data was expected to be at its offsets in the input", idx)
+			return
+		}
+	}
+}
+
+func TestSelector_executeNodes_isSuccess(t *testing.T) {
+	schema := `
+		%rootToken;
+		-space;
+		-endOfLine;
+
+		rootToken : .openParenthesis .rootToken .closeParenthesis
+				  | .five .smallerThan .five
+				  ;
+
+		openParenthesis: $40;
+		closeParenthesis: $41;
+		five: $53;
+		smallerThan: $60;
+		space: $32;
+		endOfLine: $10;
+	`
+
+	data := []byte("(( 5 < 5 ))")
+	validatorApp := validator.NewApplication()
+	validator, err := validatorApp.Compile(schema)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	result, err := validatorApp.Execute(validator, data, true)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	application := NewApplication()
+	selectorIns, _, err := application.Compile("+ @rootToken > .rootToken")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	tokens, err := application.ExecuteNodes(selectorIns, result)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(tokens) != 2 {
+		t.Errorf("%d tokens were expected, %d returned", 2, len(tokens))
+		return
+	}
+
+	expected := []string{
+		"( 5 < 5 )",
+		"5 < 5",
+	}
+
+	for idx, oneToken := range tokens {
+		if oneToken.Name() != "rootToken" {
+			t.Errorf("the token (index: %d) was expected to be named '%s', '%s' returned", idx, "rootToken", oneToken.Name())
+			return
+		}
+
+		block := oneToken.Block()
+		input := block.Input()
+		content := input[:len(input)-len(block.Remaining())]
+		if string(content) != expected[idx] {
+			t.Errorf("the token (index: %d) was expected to contain '%s', '%s' returned", idx, expected[idx], content)
+			return
+		}
+	}
+
+	anySelector, _, err := application.Compile("+ @rootToken .five *")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = application.ExecuteNodes(anySelector, result)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestSelector_withSibling_isSuccess(t *testing.T) {
+	schema := `
+		%rootToken;
+		-space;
+		-endOfLine;
+
+		rootToken: .bytes
+				 | .byte
+				 ;
+
+		bytes: .openSquareBracket .byteWithSemiColon[1,] .closeSquareBracket;
+		byteWithSemiColon: .byte .semiColon;
+		byte: .dollar .number[1,3];
+
+		number: .zero
+			  | .one
+			  | .two
+			  | .three
+			  | .four
+			  | .five
+			  | .six
+			  | .seven
+			  | .height
+			  | .nine
+			  ;
+
+		openSquareBracket: $91;
+		closeSquareBracket: $93;
+		semiColon: $59;
+		dollar: $36;
+		zero: $48;
+		one: $49;
+		two: $50;
+		three: $51;
+		four: $52;
+		five: $53;
+		six: $54;
+		seven: $55;
+		height: $56;
+		nine: $57;
+		space: $32;
+		endOfLine: $10;
+	`
+
+	data := []byte("[$100; $20; $30;]")
+	validatorApp := validator.NewApplication()
+	validator, err := validatorApp.Compile(schema)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	result, err := validatorApp.Execute(validator, data, true)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	selectors := map[string][][]byte{
+		"+ @bytes .openSquareBracket ~ .closeSquareBracket": {
+			[]byte("]"),
+		},
+		"+ @bytes .closeSquareBracket ~< .openSquareBracket": {
+			[]byte("["),
+		},
+		"+ @bytes .openSquareBracket ~ .*[0]": {
+			[]byte("$100;"),
+		},
+		`+ @bytes .byteWithSemiColon[="$20;"] ~ .byteWithSemiColon`: {
+			[]byte("$30;"),
+		},
+		`+ @bytes .byteWithSemiColon[="$30;"] ~< .byteWithSemiColon[-1]`: {
+			[]byte("$20;"),
+		},
+		"+ @byte .dollar ~ .number[0]": {
+			[]byte("1"),
+			[]byte("2"),
+			[]byte("3"),
+		},
+		"+ @bytes .openSquareBracket ~< .*":               {},
+		"@bytes .openSquareBracket ~ .closeSquareBracket": {},
+	}
+
+	application := NewApplication()
+	for selector, expected := range selectors {
+		selectorIns, _, err := application.Compile(selector)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retBytes, err := application.Execute(selectorIns, result)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if len(retBytes) != len(expected) {
+			t.Errorf("%d elements were expected, %d returned (selector: %s)", len(expected), len(retBytes), selector)
+			return
+		}
+
+		for idx, data := range retBytes {
+			if bytes.Compare(data, expected[idx]) != 0 {
+				t.Errorf("%v bytes  were expected, %v returned at index: %d (selector: %s)", expected[idx], data, idx, selector)
+				return
+			}
+		}
+	}
+}
+
+func TestSelector_withAncestor_isSuccess(t *testing.T) {
+	schema := `
+		%rootToken;
+		-space;
+		-endOfLine;
+
+		rootToken : .openParenthesis .rootToken .closeParenthesis
+				  | .five .smallerThan .five
+				  ;
+
+		openParenthesis: $40;
+		closeParenthesis: $41;
+		five: $53;
+		smallerThan: $60;
+		space: $32;
+		endOfLine: $10;
+	`
+
+	data := []byte("(( 5 < 5 ))")
+	validatorApp := validator.NewApplication()
+	validator, err := validatorApp.Compile(schema)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	result, err := validatorApp.Execute(validator, data, true)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	selectors := map[string][][]byte{
+		"+ .five ^": {
+			[]byte("5<5"),
+		},
+		"+ .smallerThan ^^ .rootToken": {
+			[]byte("5<5"),
+		},
+		"+ .five ^^ .rootToken:not(@rootToken > .rootToken)": {
+			[]byte("(( 5 < 5 ))"),
+		},
+		"+ @rootToken .openParenthesis ^": {
+			[]byte("(( 5 < 5 ))"),
+			[]byte("(5 < 5)"),
+		},
+		"+ .five ^^ .openParenthesis": {},
+		"+ .rootToken ^":              {},
+		".five ^":                     {},
+	}
+
+	application := NewApplication()
+	for selector, expected := range selectors {
+		selectorIns, _, err := application.Compile(selector)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retBytes, err := application.Execute(selectorIns, result)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if len(retBytes) != len(expected) {
+			t.Errorf("%d elements were expected, %d returned (selector: %s)", len(expected), len(retBytes), selector)
+			return
+		}
+
+		for idx, data := range retBytes {
+			if bytes.Compare(data, expected[idx]) != 0 {
+				t.Errorf("%v bytes  were expected, %v returned at index: %d (selector: %s)", expected[idx], data, idx, selector)
+				return
+			}
+		}
+	}
+}
+
+func TestSelector_withBetween_isSuccess(t *testing.T) {
+	schema := `
+		%rootToken;
+		-space;
+		-endOfLine;
+
+		rootToken : .openParenthesis .rootToken .closeParenthesis
+				  | .five .smallerThan .five
+				  ;
+
+		openParenthesis: $40;
+		closeParenthesis: $41;
+		five: $53;
+		smallerThan: $60;
+		space: $32;
+		endOfLine: $10;
+	`
+
+	data := []byte("(( 5 < 5 ))")
+	validatorApp := validator.NewApplication()
+	validator, err := validatorApp.Compile(schema)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	result, err := validatorApp.Execute(validator, data, true)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	selectors := map[string][][]byte{
+		"+ .five * .five": {
+			[]byte(" < "),
+		},
+		"+ .five .. .five": {
+			[]byte("5 < 5"),
+		},
+		"+ .openParenthesis * .closeParenthesis": {
+			[]byte("( 5 < 5 "),
+			[]byte(" 5 < 5 "),
+		},
+		"+ @rootToken .openParenthesis[0] .. .closeParenthesis": {
+			[]byte("(( 5 < 5 )"),
+		},
+		"+ .five * .five , + .smallerThan": {
+			[]byte(" < "),
+			[]byte("<"),
+		},
+		"+ .smallerThan * .openParenthesis": {},
+		".five * .five":                     {},
+	}
+
+	application := NewApplication()
+	for selector, expected := range selectors {
+		selectorIns, _, err := application.Compile(selector)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retBytes, err := application.Execute(selectorIns, result)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if len(retBytes) != len(expected) {
+			t.Errorf("%d elements were expected, %d returned (selector: %s)", len(expected), len(retBytes), selector)
+			return
+		}
+
+		for idx, data := range retBytes {
+			if bytes.Compare(data, expected[idx]) != 0 {
+				t.Errorf("%v bytes  were expected, %v returned at index: %d (selector: %s)", expected[idx], data, idx, selector)
+				return
+			}
+		}
+	}
+}
+
+func TestSelector_withAnyElement_matrix_isSuccess(t *testing.T) {
+	schema := `
+		%rootToken;
+		-space;
+		-endOfLine;
+
+		rootToken: .bytes
+				 | .byte
+				 ;
+
+		bytes: .openSquareBracket .byteWithSemiColon[1,] .closeSquareBracket;
+		byteWithSemiColon: .byte .semiColon;
+		byte: .dollar .number[1,3];
+
+		number: .zero
+			  | .one
+			  | .two
+			  | .three
+			  | .four
+			  | .five
+			  | .six
+			  | .seven
+			  | .height
+			  | .nine
+			  ;
+
+		openSquareBracket: $91;
+		closeSquareBracket: $93;
+		semiColon: $59;
+		dollar: $36;
+		zero: $48;
+		one: $49;
+		two: $50;
+		three: $51;
+		four: $52;
+		five: $53;
+		six: $54;
+		seven: $55;
+		height: $56;
+		nine: $57;
+		space: $32;
+		endOfLine: $10;
+	`
+
+	validatorApp := validator.NewApplication()
+	validator, err := validatorApp.Compile(schema)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	cases := []struct {
+		data     string
+		selector string
+		expected [][]byte
+	}{
+		// repeated values:
+		{
+			data:     "[$20; $20; $20;]",
+			selector: "+ .byte *",
+			expected: [][]byte{
+				[]byte("; $20; $20;]"),
+				[]byte("; $20;]"),
+				[]byte(";]"),
+			},
+		},
+		{
+			data:     "[$20; $20; $20;]",
+			selector: "+ @bytes .byteWithSemiColon[1] *",
+			expected: [][]byte{
+				[]byte(" $20;]"),
+			},
+		},
+		// multi-byte prefixes:
+		{
+			data:     "[$100; $20;]",
+			selector: "+ .number *",
+			expected: [][]byte{
+				[]byte("; $20;]"),
+				[]byte(";]"),
+			},
+		},
+		{
+			data:     "[$100; $20;]",
+			selector: "+ @bytes .byteWithSemiColon *",
+			expected: [][]byte{
+				[]byte("]"),
+			},
+		},
+		// channel bytes:
+		{
+			data:     "[$100;\n  $20;]  ",
+			selector: "+ .byte *",
+			expected: [][]byte{
+				[]byte(";\n  $20;]"),
+				[]byte(";]"),
+			},
+		},
+		{
+			data:     "[$100;\n  $20;]  ",
+			selector: "+ .openSquareBracket *",
+			expected: [][]byte{
+				[]byte("$100;\n  $20;]"),
+			},
+		},
+		{
+			data:     "[$100;]",
+			selector: "+ .closeSquareBracket *",
+			expected: [][]byte{
+				[]byte{},
+			},
+		},
+	}
+
+	application := NewApplication()
+	for _, oneCase := range cases {
+		result, err := validatorApp.Execute(validator, []byte(oneCase.data), true)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		selectorIns, _, err := application.Compile(oneCase.selector)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retBytes, err := application.Execute(selectorIns, result)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if len(retBytes) != len(oneCase.expected) {
+			t.Errorf("%d elements were expected, %d returned (data: %q, selector: %s)", len(oneCase.expected), len(retBytes), oneCase.data, oneCase.selector)
+			return
+		}
+
+		for idx, data := range retBytes {
+			if bytes.Compare(data, oneCase.expected[idx]) != 0 {
+				t.Errorf("%q bytes  were expected, %q returned at index: %d (data: %q, selector: %s)", oneCase.expected[idx], data, idx, oneCase.data, oneCase.selector)
+				return
+			}
+		}
+	}
+}
+
+func TestSelector_withAll_isSuccess(t *testing.T) {
+	schema := `
+		%rootToken;
+		-space;
+		-endOfLine;
+
+		rootToken : .openParenthesis .rootToken .closeParenthesis
+				  | .five .smallerThan .five
+				  ;
+
+		openParenthesis: $40;
+		closeParenthesis: $41;
+		five: $53;
+		smallerThan: $60;
+		space: $32;
+		endOfLine: $10;
+	`
+
+	data := []byte("(( 5 < 5 ))")
+	validatorApp := validator.NewApplication()
+	validator, err := validatorApp.Compile(schema)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	result, err := validatorApp.Execute(validator, data, true)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	selectors := map[string][][]byte{
+		"*": {
+			[]byte("(( 5 < 5 ))"),
+		},
+		"+ *": {
+			[]byte("(( 5 < 5 ))"),
+		},
+		"+ @rootToken *": {
+			[]byte("(( 5 < 5 ))"),
+		},
+		"+ @rootToken @rootToken *": {
+			[]byte("( 5 < 5 )"),
+		},
+		"@rootToken > @rootToken > @rootToken *": {
+			[]byte("5 < 5"),
+		},
+		"@openParenthesis *": {
+			[]byte("("),
+			[]byte("("),
+		},
+		"+ @openParenthesis *": {
+			[]byte("("),
+			[]byte("("),
+		},
+		"+ @rootToken .five , *": {
+			[]byte("(( 5 < 5 ))"),
+			[]byte("5"),
+			[]byte("5"),
+		},
+		"@five @rootToken *": {},
+	}
+
+	application := NewApplication()
+	for selector, expected := range selectors {
+		selectorIns, _, err := application.Compile(selector)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retBytes, err := application.Execute(selectorIns, result)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if len(retBytes) != len(expected) {
+			t.Errorf("%d elements were expected, %d returned (selector: %s)", len(expected), len(retBytes), selector)
+			return
+		}
+
+		for idx, data := range retBytes {
+			if bytes.Compare(data, expected[idx]) != 0 {
+				t.Errorf("%v bytes  were expected, %v returned at index: %d (selector: %s)", expected[idx], data, idx, selector)
+				return
+			}
+		}
+	}
+}
+
+func TestSelector_withAlternative_isSuccess(t *testing.T) {
+	schema := `
+		%rootToken;
+		-space;
+		-endOfLine;
+
+		rootToken : .openParenthesis .rootToken .closeParenthesis
+				  | .five .smallerThan .five
+				  ;
+
+		openParenthesis: $40;
+		closeParenthesis: $41;
+		five: $53;
+		smallerThan: $60;
+		space: $32;
+		endOfLine: $10;
+	`
+
+	data := []byte("(( 5 < 5 ))")
+	validatorApp := validator.NewApplication()
+	validator, err := validatorApp.Compile(schema)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	result, err := validatorApp.Execute(validator, data, true)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	selectors := map[string][][]byte{
+		"+ .rootToken#2": {
+			[]byte("5<5"),
+		},
+		"+ .rootToken:alt(1)": {
+			[]byte("(( 5 < 5 ))"),
+		},
+		"+ @rootToken .rootToken#1": {
+			[]byte("(5 < 5)"),
+		},
+		"+ .*:not(.rootToken#1)#2": {
+			[]byte("5<5"),
+		},
+		"+ .rootToken#3": {},
+	}
+
+	application := NewApplication()
+	for selector, expected := range selectors {
+		selectorIns, _, err := application.Compile(selector)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retBytes, err := application.Execute(selectorIns, result)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if len(retBytes) != len(expected) {
+			t.Errorf("%d elements were expected, %d returned (selector: %s)", len(expected), len(retBytes), selector)
+			return
+		}
+
+		for idx, data := range retBytes {
+			if bytes.Compare(data, expected[idx]) != 0 {
+				t.Errorf("%v bytes  were expected, %v returned at index: %d (selector: %s)", expected[idx], data, idx, selector)
+				return
+			}
+		}
+	}
+
+	selectorIns, _, err := application.Compile("+ @rootToken .rootToken , + .rootToken#2")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	matches, err := application.ExecuteMatches(selectorIns, result)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := []uint{
+		0,
+		1,
+	}
+
+	if len(matches) != len(expected) {
+		t.Errorf("%d matches were expected, %d returned", len(expected), len(matches))
+		return
+	}
+
+	for idx, oneMatch := range matches {
+		if oneMatch.Alternative() != expected[idx] {
+			t.Errorf("the match (index: %d) was expected to have the alternative %d, %d returned", idx, expected[idx], oneMatch.Alternative())
+			return
+		}
+	}
+}
+
+func TestSelector_withAlternative_withUnmatchedLines_isSuccess(t *testing.T) {
+	schema := `
+		%rootToken;
+		-space;
+
+		rootToken: .pair .pair;
+		pair: .x
+			| .y
+			;
+
+		x: $120;
+		y: $121;
+		space: $32;
+	`
+
+	data := []byte("xy")
+	validatorApp := validator.NewApplication()
+	validator, err := validatorApp.Compile(schema)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	result, err := validatorApp.Execute(validator, data, true)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	selectors := map[string][][]byte{
+		"+ .y": {
+			[]byte("y"),
+		},
+		"+ .pair": {
+			[]byte("x"),
+			[]byte("y"),
+		},
+		"+ .pair#2": {
+			[]byte("y"),
+		},
+		"+ .rootToken": {
+			[]byte("xy"),
+		},
+	}
+
+	application := NewApplication()
+	for selector, expected := range selectors {
+		selectorIns, _, err := application.Compile(selector)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retBytes, err := application.Execute(selectorIns, result)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if len(retBytes) != len(expected) {
+			t.Errorf("%d elements were expected, %d returned (selector: %s)", len(expected), len(retBytes), selector)
+			return
+		}
+
+		for idx, data := range retBytes {
+			if bytes.Compare(data, expected[idx]) != 0 {
+				t.Errorf("%v bytes  were expected, %v returned at index: %d (selector: %s)", expected[idx], data, idx, selector)
+				return
+			}
+		}
+	}
+}
+
+func TestSelector_withRepetition_isSuccess(t *testing.T) {
+	schema := `
+		%rootToken;
+		-space;
+		-endOfLine;
+
+		rootToken: .bytes
+				 | .byte
+				 ;
+
+		bytes: .openSquareBracket .byteWithSemiColon[1,] .closeSquareBracket;
+		byteWithSemiColon: .byte .semiColon;
+		byte: .dollar .number[1,3];
+
+		number: .zero
+			  | .one
+			  | .two
+			  | .three
+			  | .four
+			  | .five
+			  | .six
+			  | .seven
+			  | .height
+			  | .nine
+			  ;
+
+		openSquareBracket: $91;
+		closeSquareBracket: $93;
+		semiColon: $59;
+		dollar: $36;
+		zero: $48;
+		one: $49;
+		two: $50;
+		three: $51;
+		four: $52;
+		five: $53;
+		six: $54;
+		seven: $55;
+		height: $56;
+		nine: $57;
+		space: $32;
+		endOfLine: $10;
+	`
+
+	data := []byte("[$100; $20; $30;]")
+	validatorApp := validator.NewApplication()
+	validator, err := validatorApp.Compile(schema)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	result, err := validatorApp.Execute(validator, data, true)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	selectors := map[string][][]byte{
+		"+ @byte .number{0}": {
+			[]byte("1"),
+			[]byte("2"),
+			[]byte("3"),
+		},
+		"+ @byte .number{-1}": {
+			[]byte("0"),
+			[]byte("0"),
+			[]byte("0"),
+		},
+		"+ @byte .number{1:}": {
+			[]byte("0"),
+			[]byte("0"),
+			[]byte("0"),
+			[]byte("0"),
+		},
+		"+ @byte .number{*}": {
+			[]byte("1"),
+			[]byte("0"),
+			[]byte("0"),
+			[]byte("2"),
+			[]byte("0"),
+			[]byte("3"),
+			[]byte("0"),
+		},
+		"+ @byte .number{#}": {
+			[]byte("3"),
+			[]byte("2"),
+			[]byte("2"),
+		},
+		`+ @byte .number[="0"]{#}`: {
+			[]byte("2"),
+			[]byte("1"),
+			[]byte("1"),
+		},
+		"+ @bytes .byteWithSemiColon{#}": {
+			[]byte("3"),
+		},
+		"+ @bytes .byteWithSemiColon{1}": {
+			[]byte("$20;"),
+		},
+		"+ @bytes .byteWithSemiColon{5}": {},
+		"+ @byteWithSemiColon .byte{#} , + @byteWithSemiColon .byte": {
+			[]byte("1"),
+			[]byte("$100"),
+			[]byte("1"),
+			[]byte("$20"),
+			[]byte("1"),
+			[]byte("$30"),
+		},
+	}
+
+	application := NewApplication()
+	for selector, expected := range selectors {
+		selectorIns, _, err := application.Compile(selector)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retBytes, err := application.Execute(selectorIns, result)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if len(retBytes) != len(expected) {
+			t.Errorf("%d elements were expected, %d returned (selector: %s)", len(expected), len(retBytes), selector)
+			return
+		}
+
+		for idx, data := range retBytes {
+			if bytes.Compare(data, expected[idx]) != 0 {
+				t.Errorf("%v bytes  were expected, %v returned at index: %d (selector: %s)", expected[idx], data, idx, selector)
+				return
+			}
+		}
+	}
+}
+
+func TestSelector_executeRaw_executeNormalized_isSuccess(t *testing.T) {
+	schema := `
+		%rootToken;
+		-space;
+		-endOfLine;
+
+		rootToken: .bytes
+				 | .byte
+				 ;
+
+		bytes: .openSquareBracket .byteWithSemiColon[1,] .closeSquareBracket;
+		byteWithSemiColon: .byte .semiColon;
+		byte: .dollar .number[1,3];
+
+		number: .zero
+			  | .one
+			  | .two
+			  | .three
+			  | .four
+			  | .five
+			  | .six
+			  | .seven
+			  | .height
+			  | .nine
+			  ;
+
+		openSquareBracket: $91;
+		closeSquareBracket: $93;
+		semiColon: $59;
+		dollar: $36;
+		zero: $48;
+		one: $49;
+		two: $50;
+		three: $51;
+		four: $52;
+		five: $53;
+		six: $54;
+		seven: $55;
+		height: $56;
+		nine: $57;
+		space: $32;
+		endOfLine: $10;
+	`
+
+	data := []byte("[$100;\n  $20;  $3;]  ")
+	validatorApp := validator.NewApplication()
+	validator, err := validatorApp.Compile(schema)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	result, err := validatorApp.Execute(validator, data, true)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	cases := []struct {
+		selector   string
+		raw        [][]byte
+		normalized [][]byte
+	}{
+		{
+			selector: "+ .bytes",
+			raw: [][]byte{
+				[]byte("[$100;\n  $20;  $3;]"),
+			},
+			normalized: [][]byte{
+				[]byte("[$100;$20;$3;]"),
+			},
+		},
+		{
+			selector: "+ @bytes .byteWithSemiColon",
+			raw: [][]byte{
+				[]byte("$100;\n  $20;  $3;"),
+			},
+			normalized: [][]byte{
+				[]byte("$100;$20;$3;"),
+			},
+		},
+		{
+			selector: "+ @bytes .byteWithSemiColon{1}",
+			raw: [][]byte{
+				[]byte("$20;"),
+			},
+			normalized: [][]byte{
+				[]byte("$20;"),
+			},
+		},
+		{
+			selector: "+ @bytes .byteWithSemiColon{#}",
+			raw: [][]byte{
+				[]byte("3"),
+			},
+			normalized: [][]byte{
+				[]byte("3"),
+			},
+		},
+		{
+			selector: "+ .openSquareBracket *",
+			raw: [][]byte{
+				[]byte("$100;\n  $20;  $3;]"),
+			},
+			normalized: [][]byte{
+				[]byte("$100;$20;$3;]"),
+			},
+		},
+		{
+			selector: "+ .openSquareBracket * .closeSquareBracket",
+			raw: [][]byte{
+				[]byte("$100;\n  $20;  $3;"),
+			},
+			normalized: [][]byte{
+				[]byte("$100;$20;$3;"),
+			},
+		},
+	}
+
+	application := NewApplication()
+	for _, oneCase := range cases {
+		selectorIns, _, err := application.Compile(oneCase.selector)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retRaw, err := application.ExecuteRaw(selectorIns, result)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if len(retRaw) != len(oneCase.raw) {
+			t.Errorf("%d raw elements were expected, %d returned (selector: %s)", len(oneCase.raw), len(retRaw), oneCase.selector)
+			return
+		}
+
+		for idx, data := range retRaw {
+			if bytes.Compare(data, oneCase.raw[idx]) != 0 {
+				t.Errorf("%q raw bytes  were expected, %q returned at index: %d (selector: %s)", oneCase.raw[idx], data, idx, oneCase.selector)
+				return
+			}
+		}
+
+		retNormalized, err := application.ExecuteNormalized(selectorIns, result)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if len(retNormalized) != len(oneCase.normalized) {
+			t.Errorf("%d normalized elements were expected, %d returned (selector: %s)", len(oneCase.normalized), len(retNormalized), oneCase.selector)
+			return
+		}
+
+		for idx, data := range retNormalized {
+			if bytes.Compare(data, oneCase.normalized[idx]) != 0 {
+				t.Errorf("%q normalized bytes  were expected, %q returned at index: %d (selector: %s)", oneCase.normalized[idx], data, idx, oneCase.selector)
+				return
+			}
+		}
+	}
+}
+
+func TestSelector_execute_withChannelsInsideChildTokens_isSuccess(t *testing.T) {
+	schema := `
+		%rootToken;
+		-space;
+		-endOfLine;
+
+		rootToken: .bytes;
+		bytes: .openSquareBracket .byteWithSemiColon[1,] .closeSquareBracket;
+		byteWithSemiColon: .byte .semiColon;
+		byte: .dollar .number[1,3];
+
+		number: .zero
+			  | .one
+			  | .two
+			  ;
+
+		openSquareBracket: $91;
+		closeSquareBracket: $93;
+		semiColon: $59;
+		dollar: $36;
+		zero: $48;
+		one: $49;
+		two: $50;
+		space: $32;
+		endOfLine: $10;
+	`
+
+	data := []byte("[ $1 0 0 ;$2;]")
+	validatorApp := validator.NewApplication()
+	validator, err := validatorApp.Compile(schema)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	result, err := validatorApp.Execute(validator, data, true)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	selectors := map[string][][]byte{
+		"+ .byte": {
+			[]byte("$100"),
+			[]byte("$2"),
+		},
+		"+ .byteWithSemiColon": {
+			[]byte("$1 0 0;$2;"),
+		},
+		"+ .bytes": {
+			[]byte("[$1 0 0 ;$2;]"),
+		},
+		"+ .openSquareBracket *": {
+			[]byte(" $1 0 0 ;$2;]"),
+		},
+	}
+
+	application := NewApplication()
+	for selector, expected := range selectors {
+		selectorIns, _, err := application.Compile(selector)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retBytes, err := application.Execute(selectorIns, result)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if len(retBytes) != len(expected) {
+			t.Errorf("%d elements were expected, %d returned (selector: %s)", len(expected), len(retBytes), selector)
+			return
+		}
+
+		for idx, data := range retBytes {
+			if bytes.Compare(data, expected[idx]) != 0 {
+				t.Errorf("%q bytes  were expected, %q returned at index: %d (selector: %s)", expected[idx], data, idx, selector)
+				return
+			}
+		}
+	}
+}
+
+func TestSelector_withChannel_isSuccess(t *testing.T) {
+	schema := `
+		%rootToken;
+		-space;
+		-endOfLine;
+		-comment;
+
+		rootToken : .openParenthesis .rootToken .closeParenthesis
+				  | .five .smallerThan .five
+				  ;
+
+		comment: .hash .letter[1,] .hash;
+		letter: .a
+			  | .b
+			  | .c
+			  ;
+
+		openParenthesis: $40;
+		closeParenthesis: $41;
+		five: $53;
+		smallerThan: $60;
+		hash: $35;
+		a: $97;
+		b: $98;
+		c: $99;
+		space: $32;
+		endOfLine: $10;
+	`
+
+	data := []byte("(#ab#\n( 5 #c# < 5 ) #a#)")
+	validatorApp := validator.NewApplication()
+	grammar, err := validatorApp.Compile(schema)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	result, err := validatorApp.Execute(grammar, data, true)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	compatibleAdapter := selectors.NewCompatibilityAdapter()
+	selectors := map[string][][]byte{
+		"+ @rootToken .rootToken ::channel(.comment)": {
+			[]byte("#ab#"),
+			[]byte("#a#"),
+		},
+		"+ .five ::channel(.comment)": {
+			[]byte("#c#"),
+		},
+		"+ .five ::channel(.space)": {
+			[]byte(" "),
+			[]byte(" "),
+			[]byte(" "),
+			[]byte(" "),
+			[]byte(" "),
+		},
+		"+ @rootToken .rootToken ::channel(.comment[1])": {
+			[]byte("#a#"),
+		},
+		"+ @rootToken .rootToken ::channel(.*)": {
+			[]byte("#ab#"),
+			[]byte("\n"),
+			[]byte(" "),
+			[]byte("#a#"),
+		},
+		"+ .smallerThan ::channel(.letter)": {
+			[]byte("c"),
+		},
+		"+ .openParenthesis ::channel(.comment)": {
+			[]byte("#ab#"),
+		},
+	}
+
+	application := NewApplicationWithGrammar(grammar)
+	for selector, expected := range selectors {
+		selectorIns, _, err := application.Compile(selector)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retBytes, err := application.Execute(selectorIns, result)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if len(retBytes) != len(expected) {
+			t.Errorf("%d elements were expected, %d returned (selector: %s)", len(expected), len(retBytes), selector)
+			return
+		}
+
+		for idx, data := range retBytes {
+			if bytes.Compare(data, expected[idx]) != 0 {
+				t.Errorf("%v bytes  were expected, %v returned at index: %d (selector: %s)", expected[idx], data, idx, selector)
+				return
+			}
+		}
+	}
+
+	compatible := NewApplicationWithAdapterAndGrammar(compatibleAdapter, grammar)
+	compatibleIns, err := compatible.CompileStrict("+.fi ve::chan nel(.comment)")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retBytes, err := compatible.Execute(compatibleIns, result)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(retBytes) != 1 || !bytes.Equal(retBytes[0], []byte("#c#")) {
+		t.Errorf("the compatible bytes were expected to be %q, %q returned", "#c#", retBytes)
+		return
+	}
+
+	selectorIns, _, err := NewApplication().Compile("+ .five ::channel(.comment)")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = NewApplication().Execute(selectorIns, result)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestSelector_withCompatibilityAdapter_isSuccess(t *testing.T) {
+	schema := `
+		%rootToken;
+		-space;
+		-endOfLine;
+
+		rootToken : .five .smallerThan .five
+				  ;
+
+		five: $53;
+		smallerThan: $60;
+		space: $32;
+		endOfLine: $10;
+	`
+
+	data := []byte("5 < 5")
+	validatorApp := validator.NewApplication()
+	validator, err := validatorApp.Compile(schema)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	result, err := validatorApp.Execute(validator, data, true)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	script := "+ @ root Token .smaller Than*"
+	_, err = NewApplication().CompileStrict(script)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	application := NewApplicationWithAdapter(selectors.NewCompatibilityAdapter())
+	selectorIns, err := application.CompileStrict(script)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retBytes, err := application.Execute(selectorIns, result)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := []byte(" 5")
+	if len(retBytes) != 1 || !bytes.Equal(retBytes[0], expected) {
+		t.Errorf("the bytes were expected to be %q, %q returned", expected, retBytes)
+		return
+	}
+}
+
+func TestSelector_withTokenNameCharactersAdapter_isSuccess(t *testing.T) {
+	schema := `
+		%rootToken;
+		-space;
+		-endOfLine;
+
+		rootToken : .five .smallerThan .five
+				  ;
+
+		five: $53;
+		smallerThan: $60;
+		space: $32;
+		endOfLine: $10;
+	`
+
+	data := []byte("5 < 5")
+	validatorApp := validator.NewApplication()
+	validator, err := validatorApp.Compile(schema)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	result, err := validatorApp.Execute(validator, data, true)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	// the grammar token names only contain letters:
+	letters := []byte("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")
+	application := NewApplicationWithAdapter(selectors.NewAdapterWithTokenNameCharacters(letters, []byte{}))
+
+	script := "+ .smaller_than"
+	_, err = NewApplication().CompileStrict(script)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = application.CompileStrict(script)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	selectorIns, err := application.CompileStrict("+ .smallerThan")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retBytes, err := application.Execute(selectorIns, result)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := []byte("<")
+	if len(retBytes) != 1 || !bytes.Equal(retBytes[0], expected) {
+		t.Errorf("the bytes were expected to be %q, %q returned", expected, retBytes)
+		return
+	}
+}
+
+func TestSelector_executeMatches_withLeadingChannels_isSuccess(t *testing.T) {
+	schema := `
+		%rootToken;
+		-space;
+		-endOfLine;
+
+		rootToken: .bytes;
+		bytes: .openSquareBracket .byteWithSemiColon[1,] .closeSquareBracket;
+		byteWithSemiColon: .byte .semiColon;
+		byte: .dollar .number[1,3];
+
+		number: .zero
+			  | .one
+			  | .two
+			  ;
+
+		openSquareBracket: $91;
+		closeSquareBracket: $93;
+		semiColon: $59;
+		dollar: $36;
+		zero: $48;
+		one: $49;
+		two: $50;
+		space: $32;
+		endOfLine: $10;
+	`
+
+	validatorApp := validator.NewApplication()
+	validator, err := validatorApp.Compile(schema)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	cases := []struct {
+		data    string
+		offsets [][]uint
+	}{
+		{
+			data: "  [ $1 0 0 ;$2;]  ",
+			offsets: [][]uint{
+				{4, 10},
+				{12, 14},
+			},
+		},
+		{
+			data: "\n [$1;]\n \n",
+			offsets: [][]uint{
+				{3, 5},
+			},
+		},
+		{
+			data: "ab  [$1;]  x",
+			offsets: [][]uint{
+				{5, 7},
+			},
+		},
+	}
+
+	application := NewApplication()
+	selectorIns, _, err := application.Compile("+ .byte")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	for _, oneCase := range cases {
+		result, err := validatorApp.Execute(validator, []byte(oneCase.data), true)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		matches, err := application.ExecuteMatches(selectorIns, result)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if len(matches) != len(oneCase.offsets) {
+			t.Errorf("%d matches were expected, %d returned (data: %q)", len(oneCase.offsets), len(matches), oneCase.data)
+			return
+		}
+
+		for idx, oneMatch := range matches {
+			if oneMatch.Start() != oneCase.offsets[idx][0] || oneMatch.End() != oneCase.offsets[idx][1] {
+				t.Errorf("the match (index: %d) was expected to cover [%d, %d), [%d, %d) returned (data: %q)", idx, oneCase.offsets[idx][0], oneCase.offsets[idx][1], oneMatch.Start(), oneMatch.End(), oneCase.data)
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package applications
+
+type match struct {
+	start       uint
+	end         uint
+	name        string
+	path        []string
+	alternative uint
+	data        []byte
+}
+
+func createMatch(
+	start uint,
+	end uint,
+	name string,
+	path []string,
+	alternative uint,
+	data []byte,
+) Match {
+	out := match{
+		start:       start,
+		end:         end,
+		name:        name,
+		path:        path,
+		alternative: alternative,
+		data:        data,
+	}
+
+	return &out
+}
+
+// Start returns the start offset
+func (obj *match) Start() uint {
+	return obj.start
+}
+
+// End returns the end offset
+func (obj *match) End() uint {
+	return obj.end
+}
+
+// Name returns the token name
+func (obj *match) Name() string {
+	return obj.name
+}
+
+// Path returns the token path
+func (obj *match) Path() []string {
+	return obj.path
+}
+
+// Alternative returns the index of the token's matched line, starting at 0 like results.Line.Index()
+func (obj *match) Alternative() uint {
+	return obj.alternative
+}
+
+// Data returns the data
+func (obj *match) Data() []byte {
+	return obj.data
+}
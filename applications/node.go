@@ -0,0 +1,32 @@
+package applications
+
+import "github.com/steve-care-software/validator/domain/results"
+
+type node struct {
+	token     results.Token
+	element   results.ElementWithCardinality
+	ancestors []results.Token
+}
+
+func createNode(
+	token results.Token,
+	element results.ElementWithCardinality,
+	ancestors []results.Token,
+) *node {
+	out := node{
+		token:     token,
+		element:   element,
+		ancestors: ancestors,
+	}
+
+	return &out
+}
+
+// isRepetitionOf returns true if both nodes are repetitions of the same element, false otherwise
+func (obj *node) isRepetitionOf(other *node) bool {
+	if obj.element == nil {
+		return false
+	}
+
+	return obj.element == other.element
+}
@@ -0,0 +1,28 @@
+package applications
+
+type output struct {
+	name   string
+	values [][]byte
+}
+
+func createOutput(
+	name string,
+	values [][]byte,
+) Output {
+	out := output{
+		name:   name,
+		values: values,
+	}
+
+	return &out
+}
+
+// Name returns the name
+func (obj *output) Name() string {
+	return obj.name
+}
+
+// Values returns the values
+func (obj *output) Values() [][]byte {
+	return obj.values
+}
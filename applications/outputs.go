@@ -0,0 +1,42 @@
+package applications
+
+import (
+	"errors"
+	"fmt"
+)
+
+type outputs struct {
+	list []Output
+	mp   map[string]Output
+}
+
+func createOutputs(
+	list []Output,
+) Outputs {
+	mp := map[string]Output{}
+	for _, oneOutput := range list {
+		mp[oneOutput.Name()] = oneOutput
+	}
+
+	out := outputs{
+		list: list,
+		mp:   mp,
+	}
+
+	return &out
+}
+
+// List returns the outputs, in the order of their statements
+func (obj *outputs) List() []Output {
+	return obj.list
+}
+
+// Fetch fetches an output by name
+func (obj *outputs) Fetch(name string) (Output, error) {
+	if ins, ok := obj.mp[name]; ok {
+		return ins, nil
+	}
+
+	str := fmt.Sprintf("the output (name: %s) is undefined", name)
+	return nil, errors.New(str)
+}
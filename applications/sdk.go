@@ -2,17 +2,103 @@ package applications
 
 import (
 	"github.com/steve-care-software/selector/domain/selectors"
+	validator "github.com/steve-care-software/validator/applications"
+	"github.com/steve-care-software/validator/domain/grammars"
 	"github.com/steve-care-software/validator/domain/results"
 )
 
 // NewApplication creates a new application instance
 func NewApplication() Application {
 	adapter := selectors.NewAdapter()
-	return createApplication(adapter)
+	return NewApplicationWithAdapter(adapter)
+}
+
+// NewApplicationWithAdapter creates a new application instance whose scripts are compiled by the adapter,
+// such as the compatibility adapter or an adapter with the token name characters of the grammar
+func NewApplicationWithAdapter(adapter selectors.Adapter) Application {
+	return newApplication(adapter, nil)
+}
+
+// NewApplicationWithGrammar creates a new application instance whose channel selectors
+// tokenize the channel bytes using the channels of the grammar
+func NewApplicationWithGrammar(grammar grammars.Grammar) Application {
+	adapter := selectors.NewAdapter()
+	return NewApplicationWithAdapterAndGrammar(adapter, grammar)
+}
+
+// NewApplicationWithAdapterAndGrammar creates a new application instance whose scripts are compiled by the adapter,
+// and whose channel selectors tokenize the channel bytes using the channels of the grammar
+func NewApplicationWithAdapterAndGrammar(adapter selectors.Adapter, grammar grammars.Grammar) Application {
+	return newApplication(adapter, grammar)
+}
+
+func newApplication(adapter selectors.Adapter, grammar grammars.Grammar) Application {
+	validatorApp := validator.NewApplication()
+	grammarBuilder := grammars.NewBuilder()
+	wildcardByte := []byte("*")[0]
+	singleWildcardByte := []byte("?")[0]
+	alternativesPrefix := []byte("{")[0]
+	alternativesSuffix := []byte("}")[0]
+	alternativesDelimiter := []byte(",")[0]
+	if grammar != nil {
+		return createApplicationWithGrammar(
+			adapter,
+			validatorApp,
+			grammarBuilder,
+			wildcardByte,
+			singleWildcardByte,
+			alternativesPrefix,
+			alternativesSuffix,
+			alternativesDelimiter,
+			grammar,
+		)
+	}
+
+	return createApplication(
+		adapter,
+		validatorApp,
+		grammarBuilder,
+		wildcardByte,
+		singleWildcardByte,
+		alternativesPrefix,
+		alternativesSuffix,
+		alternativesDelimiter,
+	)
 }
 
 // Application represents the selector application
 type Application interface {
 	Compile(script string) (selectors.Selector, []byte, error)
+	CompileStrict(script string) (selectors.Selector, error)
+	CompileStatements(script string) (selectors.Statements, []byte, error)
+	CompileStatementsStrict(script string) (selectors.Statements, error)
 	Execute(selector selectors.Selector, result results.Result) ([][]byte, error)
+	ExecuteRaw(selector selectors.Selector, result results.Result) ([][]byte, error)
+	ExecuteNormalized(selector selectors.Selector, result results.Result) ([][]byte, error)
+	ExecuteMatches(selector selectors.Selector, result results.Result) ([]Match, error)
+	ExecuteNodes(selector selectors.Selector, result results.Result) ([]results.Token, error)
+	ExecuteStatements(statements selectors.Statements, result results.Result) (Outputs, error)
+}
+
+// Outputs represents the outputs of executed statements
+type Outputs interface {
+	List() []Output
+	Fetch(name string) (Output, error)
+}
+
+// Output represents the output of an executed statement
+type Output interface {
+	Name() string
+	Values() [][]byte
+}
+
+// Match represents a match of an executed selector, its offsets index the data given to the validator,
+// and its alternative is the index of the matched line of its token, starting at 0 like results.Line.Index()
+type Match interface {
+	Start() uint
+	End() uint
+	Name() string
+	Path() []string
+	Alternative() uint
+	Data() []byte
 }
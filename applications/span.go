@@ -0,0 +1,60 @@
+package applications
+
+import (
+	"bytes"
+
+	"github.com/steve-care-software/validator/domain/results"
+)
+
+type span struct {
+	start   int
+	end     int
+	data    []byte
+	tokens  []results.Token
+	isCount bool
+}
+
+func createSpan(
+	start int,
+	end int,
+	data []byte,
+	tokens []results.Token,
+) *span {
+	return createSpanInternally(start, end, data, tokens, false)
+}
+
+func createSpanWithCount(
+	start int,
+	end int,
+	data []byte,
+	tokens []results.Token,
+) *span {
+	return createSpanInternally(start, end, data, tokens, true)
+}
+
+func createSpanInternally(
+	start int,
+	end int,
+	data []byte,
+	tokens []results.Token,
+	isCount bool,
+) *span {
+	out := span{
+		start:   start,
+		end:     end,
+		data:    data,
+		tokens:  tokens,
+		isCount: isCount,
+	}
+
+	return &out
+}
+
+// isSameAs returns true if both spans cover the same input with the same data, false otherwise
+func (obj *span) isSameAs(other *span) bool {
+	if obj.start != other.start || obj.end != other.end {
+		return false
+	}
+
+	return obj.isCount == other.isCount && bytes.Equal(obj.data, other.data)
+}
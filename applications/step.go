@@ -0,0 +1,12 @@
+package applications
+
+import "regexp"
+
+// step represents a step of a selector path, either an inside or the selected name
+type step interface {
+	Name() string
+	IsLiteral() bool
+	HasPattern() bool
+	Pattern() *regexp.Regexp
+	IsChild() bool
+}
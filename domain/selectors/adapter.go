@@ -1,217 +1,2158 @@
 package selectors
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"regexp"
+	"strconv"
 
 	"github.com/steve-care-software/validator/domain/utils"
 )
 
 type adapter struct {
-	builder             Builder
-	nameBuilder         NameBuilder
-	anyByte             byte
-	tokenNameByte       byte
-	insideByte          byte
-	selectByte          byte
-	tokenNameCharacters []byte
-	channelCharacters   []byte
+	statementsBuilder     StatementsBuilder
+	statementBuilder      StatementBuilder
+	builder               Builder
+	siblingBuilder        SiblingBuilder
+	ancestorBuilder       AncestorBuilder
+	betweenBuilder        BetweenBuilder
+	allBuilder            AllBuilder
+	channelBuilder        ChannelBuilder
+	nameBuilder           NameBuilder
+	insideBuilder         InsideBuilder
+	predicateBuilder      PredicateBuilder
+	valueBuilder          ValueBuilder
+	repetitionBuilder     RepetitionBuilder
+	indexBuilder          IndexBuilder
+	sliceBuilder          SliceBuilder
+	anyByte               byte
+	tokenNameByte         byte
+	insideByte            byte
+	childByte             byte
+	selectByte            byte
+	unionByte             byte
+	siblingByte           byte
+	precedingByte         byte
+	parentByte            byte
+	rangeBytes            []byte
+	axisPrefix            []byte
+	assignmentByte        byte
+	statementSuffix       byte
+	statementDelimiter    byte
+	lineCommentPrefix     []byte
+	blockCommentPrefix    []byte
+	blockCommentSuffix    []byte
+	predicatePrefix       byte
+	notKeyword            []byte
+	alternativeKeyword    []byte
+	channelKeyword        []byte
+	alternativeByte       byte
+	parametersPrefix      byte
+	parametersSuffix      byte
+	equalByte             byte
+	prefixByte            byte
+	containsByte          byte
+	patternByte           byte
+	quoteByte             byte
+	repetitionPrefix      byte
+	repetitionSuffix      byte
+	repetitionListByte    byte
+	repetitionAmountByte  byte
+	indexPrefix           byte
+	indexSuffix           byte
+	sliceDelimiter        byte
+	negativeByte          byte
+	separatorByte         byte
+	tokenNameCharacters   []byte
+	tokenNameFollowing    []byte
+	wildcardCharacters    []byte
+	alternativesPrefix    byte
+	alternativesSuffix    byte
+	alternativesDelimiter byte
+	patternDelimiter      byte
+	escapeByte            byte
+	channelCharacters     []byte
+	isCompatible          bool
 }
 
 func createAdapter(
+	statementsBuilder StatementsBuilder,
+	statementBuilder StatementBuilder,
 	builder Builder,
+	siblingBuilder SiblingBuilder,
+	ancestorBuilder AncestorBuilder,
+	betweenBuilder BetweenBuilder,
+	allBuilder AllBuilder,
+	channelBuilder ChannelBuilder,
 	nameBuilder NameBuilder,
+	insideBuilder InsideBuilder,
+	predicateBuilder PredicateBuilder,
+	valueBuilder ValueBuilder,
+	repetitionBuilder RepetitionBuilder,
+	indexBuilder IndexBuilder,
+	sliceBuilder SliceBuilder,
 	anyByte byte,
 	tokenNameByte byte,
 	insideByte byte,
+	childByte byte,
 	selectByte byte,
+	unionByte byte,
+	siblingByte byte,
+	precedingByte byte,
+	parentByte byte,
+	rangeBytes []byte,
+	axisPrefix []byte,
+	assignmentByte byte,
+	statementSuffix byte,
+	statementDelimiter byte,
+	lineCommentPrefix []byte,
+	blockCommentPrefix []byte,
+	blockCommentSuffix []byte,
+	predicatePrefix byte,
+	notKeyword []byte,
+	alternativeKeyword []byte,
+	channelKeyword []byte,
+	alternativeByte byte,
+	parametersPrefix byte,
+	parametersSuffix byte,
+	equalByte byte,
+	prefixByte byte,
+	containsByte byte,
+	patternByte byte,
+	quoteByte byte,
+	repetitionPrefix byte,
+	repetitionSuffix byte,
+	repetitionListByte byte,
+	repetitionAmountByte byte,
+	indexPrefix byte,
+	indexSuffix byte,
+	sliceDelimiter byte,
+	negativeByte byte,
+	separatorByte byte,
 	tokenNameCharacters []byte,
+	tokenNameFollowing []byte,
+	wildcardCharacters []byte,
+	alternativesPrefix byte,
+	alternativesSuffix byte,
+	alternativesDelimiter byte,
+	patternDelimiter byte,
+	escapeByte byte,
 	channelCharacters []byte,
+	isCompatible bool,
 ) Adapter {
 	out := adapter{
-		builder:             builder,
-		nameBuilder:         nameBuilder,
-		anyByte:             anyByte,
-		tokenNameByte:       tokenNameByte,
-		insideByte:          insideByte,
-		selectByte:          selectByte,
-		tokenNameCharacters: tokenNameCharacters,
-		channelCharacters:   channelCharacters,
+		statementsBuilder:     statementsBuilder,
+		statementBuilder:      statementBuilder,
+		builder:               builder,
+		siblingBuilder:        siblingBuilder,
+		ancestorBuilder:       ancestorBuilder,
+		betweenBuilder:        betweenBuilder,
+		allBuilder:            allBuilder,
+		channelBuilder:        channelBuilder,
+		nameBuilder:           nameBuilder,
+		insideBuilder:         insideBuilder,
+		predicateBuilder:      predicateBuilder,
+		valueBuilder:          valueBuilder,
+		repetitionBuilder:     repetitionBuilder,
+		indexBuilder:          indexBuilder,
+		sliceBuilder:          sliceBuilder,
+		anyByte:               anyByte,
+		tokenNameByte:         tokenNameByte,
+		insideByte:            insideByte,
+		childByte:             childByte,
+		selectByte:            selectByte,
+		unionByte:             unionByte,
+		siblingByte:           siblingByte,
+		precedingByte:         precedingByte,
+		parentByte:            parentByte,
+		rangeBytes:            rangeBytes,
+		axisPrefix:            axisPrefix,
+		assignmentByte:        assignmentByte,
+		statementSuffix:       statementSuffix,
+		statementDelimiter:    statementDelimiter,
+		lineCommentPrefix:     lineCommentPrefix,
+		blockCommentPrefix:    blockCommentPrefix,
+		blockCommentSuffix:    blockCommentSuffix,
+		predicatePrefix:       predicatePrefix,
+		notKeyword:            notKeyword,
+		alternativeKeyword:    alternativeKeyword,
+		channelKeyword:        channelKeyword,
+		alternativeByte:       alternativeByte,
+		parametersPrefix:      parametersPrefix,
+		parametersSuffix:      parametersSuffix,
+		equalByte:             equalByte,
+		prefixByte:            prefixByte,
+		containsByte:          containsByte,
+		patternByte:           patternByte,
+		quoteByte:             quoteByte,
+		repetitionPrefix:      repetitionPrefix,
+		repetitionSuffix:      repetitionSuffix,
+		repetitionListByte:    repetitionListByte,
+		repetitionAmountByte:  repetitionAmountByte,
+		indexPrefix:           indexPrefix,
+		indexSuffix:           indexSuffix,
+		sliceDelimiter:        sliceDelimiter,
+		negativeByte:          negativeByte,
+		separatorByte:         separatorByte,
+		tokenNameCharacters:   tokenNameCharacters,
+		tokenNameFollowing:    tokenNameFollowing,
+		wildcardCharacters:    wildcardCharacters,
+		alternativesPrefix:    alternativesPrefix,
+		alternativesSuffix:    alternativesSuffix,
+		alternativesDelimiter: alternativesDelimiter,
+		patternDelimiter:      patternDelimiter,
+		escapeByte:            escapeByte,
+		channelCharacters:     channelCharacters,
+		isCompatible:          isCompatible,
 	}
 
 	return &out
 }
 
+// StatementsToScript converts statements to script
+func (app *adapter) StatementsToScript(statements Statements) []byte {
+	output := []byte{}
+	list := statements.List()
+	for idx, oneStatement := range list {
+		if idx > 0 {
+			output = append(output, app.statementDelimiter)
+		}
+
+		output = append(output, app.statementToScript(oneStatement)...)
+	}
+
+	return output
+}
+
+func (app *adapter) statementToScript(statement Statement) []byte {
+	output := []byte(statement.Name())
+	output = append(output, app.separatorByte, app.assignmentByte, app.separatorByte)
+	output = append(output, app.ToScript(statement.Selector())...)
+	return append(output, app.statementSuffix)
+}
+
+// ToStatements converts a script to statements
+func (app *adapter) ToStatements(script string) (Statements, []byte, error) {
+	return app.toStatements(script, false)
+}
+
+// ToStrictStatements converts a script to statements, and returns an error if input remains after the statements
+func (app *adapter) ToStrictStatements(script string) (Statements, error) {
+	ins, _, err := app.toStatements(script, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return ins, nil
+}
+
+func (app *adapter) toStatements(script string, isStrict bool) (Statements, []byte, error) {
+	// convert to bytes:
+	bytes := []byte(script)
+
+	// tokenize the script, removing its channel characters and comments:
+	remainingAfterChans, positions, _, err := app.tokenize(bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// retrieve the statements:
+	ins, remaining, err := app.retrieveStatements(remainingAfterChans)
+	if err != nil {
+		return nil, nil, app.toParseError(bytes, remainingAfterChans, positions, err)
+	}
+
+	if !isStrict || len(remaining) <= 0 {
+		return ins, remaining, nil
+	}
+
+	// the remaining input is an invalid statement, so its error explains why it could not be retrieved:
+	_, _, err = app.retrieveStatement(remaining)
+	if err == nil {
+		err = app.trailingError(remaining)
+	}
+
+	return nil, nil, app.toParseError(bytes, remainingAfterChans, positions, err)
+}
+
+// FormatStatements formats a statements script, and keeps its comments at the line level: a comment inside a statement
+// is written on its own line before it, while a comment following a statement on its line stays after it
+func (app *adapter) FormatStatements(script string) ([]byte, error) {
+	_, err := app.ToStrictStatements(script)
+	if err != nil {
+		return nil, err
+	}
+
+	bytes := []byte(script)
+	remainingAfterChans, positions, comments, err := app.tokenize(bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	scripts := [][]byte{}
+	ends := []int{}
+	remaining := remainingAfterChans
+	for len(remaining) > 0 {
+		statement, remainingAfterStatement, err := app.retrieveStatement(remaining)
+		if err != nil {
+			return nil, app.toParseError(bytes, remainingAfterChans, positions, err)
+		}
+
+		consumed := len(remainingAfterChans) - len(remainingAfterStatement)
+		scripts = append(scripts, app.statementToScript(statement))
+		ends = append(ends, positions[consumed-1]+1)
+		remaining = remainingAfterStatement
+	}
+
+	return app.formatComments(bytes, scripts, ends, comments), nil
+}
+
+// FormatSelector formats a selector script, and keeps its comments at the line level: a comment inside the selector,
+// even between the selectors of a union, is written on its own line before it, while a comment following it stays after it
+func (app *adapter) FormatSelector(script string) ([]byte, error) {
+	selector, err := app.ToStrictSelector(script)
+	if err != nil {
+		return nil, err
+	}
+
+	bytes := []byte(script)
+	_, positions, comments, err := app.tokenize(bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	scripts := [][]byte{
+		app.ToScript(selector),
+	}
+
+	ends := []int{
+		positions[len(positions)-1] + 1,
+	}
+
+	return app.formatComments(bytes, scripts, ends, comments), nil
+}
+
+// formatComments writes each script on its own line, the comments located before the end of a script are written on their own lines before it,
+// and a comment starting on the line where a script ends is kept after it
+func (app *adapter) formatComments(input []byte, scripts [][]byte, ends []int, comments []*lexeme) []byte {
+	lines := [][]byte{}
+	commentIndex := 0
+	for idx, oneScript := range scripts {
+		for commentIndex < len(comments) && comments[commentIndex].offset < ends[idx] {
+			lines = append(lines, comments[commentIndex].data)
+			commentIndex++
+		}
+
+		line := append([]byte{}, oneScript...)
+		if commentIndex < len(comments) && !bytes.Contains(input[ends[idx]:comments[commentIndex].offset], []byte{app.statementDelimiter}) {
+			line = append(line, app.separatorByte)
+			line = append(line, comments[commentIndex].data...)
+			commentIndex++
+		}
+
+		lines = append(lines, line)
+	}
+
+	for _, oneComment := range comments[commentIndex:] {
+		lines = append(lines, oneComment.data)
+	}
+
+	return bytes.Join(lines, []byte{app.statementDelimiter})
+}
+
 // ToScript converts a selector to script
 func (app *adapter) ToScript(selector Selector) []byte {
-	return nil
+	if selector.IsUnion() {
+		output := []byte{}
+		union := selector.Union()
+		for idx, oneSelector := range union {
+			if idx > 0 {
+				output = append(output, app.separatorByte, app.unionByte, app.separatorByte)
+			}
+
+			output = append(output, app.ToScript(oneSelector)...)
+		}
+
+		return output
+	}
+
+	if selector.IsName() {
+		name := selector.Name()
+		return app.nameToScript(name)
+	}
+
+	if selector.IsSibling() {
+		sibling := selector.Sibling()
+		output := app.nameToScript(sibling.Name())
+		output = append(output, app.separatorByte, app.siblingByte)
+		if sibling.IsPreceding() {
+			output = append(output, app.precedingByte)
+		}
+
+		output = append(output, app.separatorByte)
+		return append(output, app.nameToScript(sibling.Target())...)
+	}
+
+	if selector.IsAncestor() {
+		ancestor := selector.Ancestor()
+		output := app.nameToScript(ancestor.Name())
+		output = append(output, app.separatorByte, app.parentByte)
+		if ancestor.HasTarget() {
+			output = append(output, app.parentByte, app.separatorByte)
+			output = append(output, app.nameToScript(ancestor.Target())...)
+		}
+
+		return output
+	}
+
+	if selector.IsBetween() {
+		between := selector.Between()
+		output := app.nameToScript(between.From())
+		output = append(output, app.separatorByte)
+		if between.IsInclusive() {
+			output = append(output, app.rangeBytes...)
+		}
+
+		if !between.IsInclusive() {
+			output = append(output, app.anyByte)
+		}
+
+		output = append(output, app.separatorByte)
+		return append(output, app.nameToScript(between.To())...)
+	}
+
+	if selector.IsAll() {
+		all := selector.All()
+		output := []byte{}
+		if all.IsSelected() {
+			output = append(output, app.selectByte, app.separatorByte)
+		}
+
+		if all.HasInsides() {
+			output = append(output, app.insidesToScript(all.Insides())...)
+		}
+
+		return append(output, app.anyByte)
+	}
+
+	if selector.IsChannel() {
+		channel := selector.Channel()
+		output := app.nameToScript(channel.Name())
+		output = append(output, app.separatorByte)
+		output = append(output, app.axisPrefix...)
+		output = append(output, app.channelKeyword...)
+		output = append(output, app.parametersPrefix)
+		output = append(output, app.nameToScript(channel.Target())...)
+		return append(output, app.parametersSuffix)
+	}
+
+	any := selector.Any()
+	output := app.nameToScript(any)
+	return append(output, app.separatorByte, app.anyByte)
+}
+
+func (app *adapter) nameToScript(name Name) []byte {
+	output := []byte{}
+	if name.IsSelected() {
+		output = append(output, app.selectByte, app.separatorByte)
+	}
+
+	if name.HasInsides() {
+		insides := name.Insides()
+		output = append(output, app.insidesToScript(insides)...)
+	}
+
+	if name.IsChild() {
+		output = append(output, app.childByte, app.separatorByte)
+	}
+
+	output = append(output, app.tokenNameByte)
+	output = append(output, app.tokenNameToScript(name.Name(), name.Pattern(), name.IsLiteral(), true)...)
+	if name.HasRepetition() {
+		repetition := name.Repetition()
+		output = append(output, app.repetitionToScript(repetition)...)
+	}
+
+	if name.HasPredicates() {
+		predicates := name.Predicates()
+		for _, onePredicate := range predicates {
+			output = append(output, app.predicateToScript(onePredicate)...)
+		}
+	}
+
+	if name.HasIndex() {
+		index := name.Index()
+		output = append(output, app.indexToScript(index)...)
+	}
+
+	return output
+}
+
+func (app *adapter) insidesToScript(insides []Inside) []byte {
+	output := []byte{}
+	for _, oneInside := range insides {
+		if oneInside.IsChild() {
+			output = append(output, app.childByte, app.separatorByte)
+		}
+
+		output = append(output, app.insideByte)
+		output = append(output, app.tokenNameToScript(oneInside.Name(), oneInside.Pattern(), oneInside.IsLiteral(), false)...)
+		output = append(output, app.separatorByte)
+	}
+
+	return output
+}
+
+func (app *adapter) tokenNameToScript(name string, pattern *regexp.Regexp, isLiteral bool, isName bool) []byte {
+	// the wildcards and alternatives of a literal name are kept literal by writing it as a string:
+	if isLiteral && app.isGlob([]byte(name)) {
+		return app.stringToScript([]byte(name))
+	}
+
+	if pattern == nil {
+		// in compatibility mode, a wildcard ending the selected name would be parsed as the any selector, so the name is written as alternatives:
+		if app.isCompatible && isName && len(name) > 1 && name[len(name)-1] == app.anyByte {
+			name = fmt.Sprintf("%c%s%c", app.alternativesPrefix, name, app.alternativesSuffix)
+		}
+
+		_, remaining, err := app.fetchTokenNamePattern([]byte(name), isName)
+		if err != nil || len(remaining) > 0 {
+			return app.stringToScript([]byte(name))
+		}
+
+		return []byte(name)
+	}
+
+	output := []byte{
+		app.patternDelimiter,
+	}
+
+	isEscaped := false
+	source := []byte(pattern.String())
+	for _, oneByte := range source {
+		if !isEscaped && oneByte == app.patternDelimiter {
+			output = append(output, app.escapeByte)
+		}
+
+		isEscaped = !isEscaped && oneByte == app.escapeByte
+		output = append(output, oneByte)
+	}
+
+	return append(output, app.patternDelimiter)
+}
+
+func (app *adapter) predicateToScript(predicate Predicate) []byte {
+	if predicate.IsValue() {
+		value := predicate.Value()
+		return app.valueToScript(value)
+	}
+
+	if predicate.IsAlternative() {
+		pAlternative := predicate.Alternative()
+		output := []byte{
+			app.alternativeByte,
+		}
+
+		return append(output, []byte(strconv.Itoa(int(*pAlternative)))...)
+	}
+
+	output := []byte{
+		app.predicatePrefix,
+	}
+
+	not := predicate.Not()
+	output = append(output, app.notKeyword...)
+	output = append(output, app.parametersPrefix)
+	output = append(output, app.ToScript(not)...)
+	return append(output, app.parametersSuffix)
+}
+
+func (app *adapter) valueToScript(value Value) []byte {
+	output := []byte{
+		app.indexPrefix,
+	}
+
+	if value.IsPattern() {
+		output = append(output, app.patternByte, app.equalByte)
+		output = append(output, app.tokenNameToScript("", value.Pattern(), false, false)...)
+		return append(output, app.indexSuffix)
+	}
+
+	content := value.Equal()
+	if value.IsPrefix() {
+		output = append(output, app.prefixByte)
+		content = value.Prefix()
+	}
+
+	if value.IsContains() {
+		output = append(output, app.containsByte)
+		content = value.Contains()
+	}
+
+	output = append(output, app.equalByte)
+	output = append(output, app.stringToScript(content)...)
+	return append(output, app.indexSuffix)
+}
+
+func (app *adapter) stringToScript(content []byte) []byte {
+	output := []byte{
+		app.quoteByte,
+	}
+
+	for _, oneByte := range content {
+		if oneByte == app.quoteByte || oneByte == app.escapeByte {
+			output = append(output, app.escapeByte)
+		}
+
+		output = append(output, oneByte)
+	}
+
+	return append(output, app.quoteByte)
+}
+
+func (app *adapter) repetitionToScript(repetition Repetition) []byte {
+	output := []byte{
+		app.repetitionPrefix,
+	}
+
+	if repetition.IsList() {
+		output = append(output, app.repetitionListByte)
+	}
+
+	if repetition.IsAmount() {
+		output = append(output, app.repetitionAmountByte)
+	}
+
+	if repetition.IsIndex() {
+		output = append(output, app.indexContentToScript(repetition.Index())...)
+	}
+
+	return append(output, app.repetitionSuffix)
+}
+
+func (app *adapter) indexToScript(index Index) []byte {
+	output := []byte{
+		app.indexPrefix,
+	}
+
+	output = append(output, app.indexContentToScript(index)...)
+	return append(output, app.indexSuffix)
+}
+
+func (app *adapter) indexContentToScript(index Index) []byte {
+	output := []byte{}
+	if index.IsPosition() {
+		pPosition := index.Position()
+		return append(output, []byte(strconv.Itoa(*pPosition))...)
+	}
+
+	slice := index.Slice()
+	if slice.HasFrom() {
+		pFrom := slice.From()
+		output = append(output, []byte(strconv.Itoa(*pFrom))...)
+	}
+
+	output = append(output, app.sliceDelimiter)
+	if slice.HasTo() {
+		pTo := slice.To()
+		output = append(output, []byte(strconv.Itoa(*pTo))...)
+	}
+
+	return output
+}
+
+// ToSelector converts a script to selector
+func (app *adapter) ToSelector(script string) (Selector, []byte, error) {
+	return app.toSelector(script, false)
+}
+
+// ToStrictSelector converts a script to selector, and returns an error if input remains after the selector
+func (app *adapter) ToStrictSelector(script string) (Selector, error) {
+	ins, _, err := app.toSelector(script, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return ins, nil
+}
+
+func (app *adapter) toSelector(script string, isStrict bool) (Selector, []byte, error) {
+	// convert to bytes:
+	bytes := []byte(script)
+
+	// tokenize the script, removing its channel characters and comments:
+	remainingAfterChans, positions, _, err := app.tokenize(bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// retrieve the selector:
+	ins, remaining, err := app.retrieveSelector(remainingAfterChans)
+	if err != nil {
+		return nil, nil, app.toParseError(bytes, remainingAfterChans, positions, err)
+	}
+
+	if isStrict && len(remaining) > 0 {
+		return nil, nil, app.toParseError(bytes, remainingAfterChans, positions, app.trailingError(remaining))
+	}
+
+	return ins, remaining, nil
+}
+
+func (app *adapter) trailingError(remaining []byte) error {
+	return app.syntaxError(app.skipSeparator(remaining), "the end of the script", "the script contains input after its end")
+}
+
+func (app *adapter) retrieveStatements(data []byte) (Statements, []byte, error) {
+	remaining := data
+	list := []Statement{}
+	names := map[string]bool{}
+	for {
+		if len(remaining) <= 0 {
+			break
+		}
+
+		statement, remainingAfterStatement, err := app.retrieveStatement(remaining)
+		if err != nil {
+			if len(list) > 0 {
+				break
+			}
+
+			return nil, nil, err
+		}
+
+		name := statement.Name()
+		if names[name] {
+			str := fmt.Sprintf("the statement (name: %s) is declared more than once", name)
+			return nil, nil, app.syntaxError(remaining, "a statement name declared once", str)
+		}
+
+		names[name] = true
+		list = append(list, statement)
+		remaining = remainingAfterStatement
+	}
+
+	ins, err := app.statementsBuilder.Create().WithList(list).Now()
+	if err != nil {
+		return nil, nil, app.builderError(data, "a statement", err)
+	}
+
+	return ins, remaining, nil
+}
+
+func (app *adapter) retrieveStatement(data []byte) (Statement, []byte, error) {
+	name, remainingAfterName, err := app.fetchTokenName(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(remainingAfterName) <= 0 || remainingAfterName[0] != app.assignmentByte {
+		str := fmt.Sprintf("the statement (name: %s) was expecting an assignment byte (%d), none provided", name, app.assignmentByte)
+		return nil, nil, app.syntaxError(remainingAfterName, string(app.assignmentByte), str)
+	}
+
+	selector, remainingAfterSelector, err := app.retrieveSelector(remainingAfterName[1:])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(remainingAfterSelector) <= 0 || remainingAfterSelector[0] != app.statementSuffix {
+		str := fmt.Sprintf("the statement (name: %s) was expecting a suffix byte (%d), none provided", name, app.statementSuffix)
+		return nil, nil, app.syntaxError(remainingAfterSelector, string(app.statementSuffix), str)
+	}
+
+	ins, err := app.statementBuilder.Create().WithName(name).WithSelector(selector).Now()
+	if err != nil {
+		return nil, nil, app.builderError(data, "a statement", err)
+	}
+
+	return ins, remainingAfterSelector[1:], nil
+}
+
+func (app *adapter) retrieveSelector(data []byte) (Selector, []byte, error) {
+	selector, remaining, err := app.retrieveSingleSelector(data)
+	if err != nil {
+		return nil, nil, app.positionError(data, err)
+	}
+
+	union := []Selector{
+		selector,
+	}
+
+	for {
+		if len(remaining) <= 0 || remaining[0] != app.unionByte {
+			break
+		}
+
+		selector, remainingAfterSelector, err := app.retrieveSingleSelector(remaining[1:])
+		if err != nil {
+			return nil, nil, app.positionError(remaining[1:], err)
+		}
+
+		union = append(union, selector)
+		remaining = remainingAfterSelector
+	}
+
+	if len(union) <= 1 {
+		return selector, remaining, nil
+	}
+
+	ins, err := app.builder.Create().WithUnion(union).Now()
+	if err != nil {
+		return nil, nil, app.builderError(data, "a selector", err)
+	}
+
+	return ins, remaining, nil
+}
+
+func (app *adapter) retrieveSingleSelector(data []byte) (Selector, []byte, error) {
+	all, remainingAfterAll, err := app.retrieveAll(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if all != nil {
+		ins, err := app.builder.Create().WithAll(all).Now()
+		if err != nil {
+			return nil, nil, app.builderError(data, "a selector", err)
+		}
+
+		return ins, remainingAfterAll, nil
+	}
+
+	name, remainingAfterName, err := app.retrieveElementName(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	remainingAfterSeparator := app.skipSeparator(remainingAfterName)
+	if bytes.HasPrefix(remainingAfterSeparator, app.rangeBytes) {
+		return app.retrieveBetween(name, remainingAfterSeparator[len(app.rangeBytes):], true)
+	}
+
+	isBetween := len(remainingAfterSeparator) > 1 && remainingAfterSeparator[0] == app.anyByte && remainingAfterSeparator[1] == app.tokenNameByte
+	if isBetween {
+		return app.retrieveBetween(name, remainingAfterSeparator[1:], false)
+	}
+
+	if len(remainingAfterSeparator) > 0 && remainingAfterSeparator[0] == app.anyByte {
+		ins, err := app.builder.Create().WithAny(name).Now()
+		if err != nil {
+			return nil, nil, app.builderError(data, "a name", err)
+		}
+
+		return ins, remainingAfterSeparator[1:], nil
+	}
+
+	if len(remainingAfterSeparator) > 0 && remainingAfterSeparator[0] == app.siblingByte {
+		sibling, remainingAfterSibling, err := app.retrieveSibling(name, remainingAfterSeparator[1:])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		ins, err := app.builder.Create().WithSibling(sibling).Now()
+		if err != nil {
+			return nil, nil, app.builderError(data, "a selector", err)
+		}
+
+		return ins, remainingAfterSibling, nil
+	}
+
+	if bytes.HasPrefix(remainingAfterSeparator, app.axisPrefix) {
+		channel, remainingAfterChannel, err := app.retrieveChannel(name, remainingAfterSeparator[len(app.axisPrefix):])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		ins, err := app.builder.Create().WithChannel(channel).Now()
+		if err != nil {
+			return nil, nil, app.builderError(data, "a selector", err)
+		}
+
+		return ins, remainingAfterChannel, nil
+	}
+
+	if len(remainingAfterSeparator) > 0 && remainingAfterSeparator[0] == app.parentByte {
+		ancestor, remainingAfterAncestor, err := app.retrieveAncestor(name, remainingAfterSeparator[1:])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		ins, err := app.builder.Create().WithAncestor(ancestor).Now()
+		if err != nil {
+			return nil, nil, app.builderError(data, "a selector", err)
+		}
+
+		return ins, remainingAfterAncestor, nil
+	}
+
+	ins, err := app.builder.Create().WithName(name).Now()
+	if err != nil {
+		return nil, nil, app.builderError(data, "a selector", err)
+	}
+
+	return ins, remainingAfterName, nil
+}
+
+func (app *adapter) retrieveAll(data []byte) (All, []byte, error) {
+	isSelected, remainingAfterSelected := app.elementIsSelected(data)
+	insides, isChild, remainingAfterInsides, err := app.retrieveElementInsides(app.skipSeparator(remainingAfterSelected))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	remaining := app.skipSeparator(remainingAfterInsides)
+	if isChild || len(remaining) <= 0 || remaining[0] != app.anyByte {
+		return nil, data, nil
+	}
+
+	builder := app.allBuilder.Create().WithInsides(insides)
+	if isSelected {
+		builder.IsSelected()
+	}
+
+	ins, err := builder.Now()
+	if err != nil {
+		return nil, nil, app.builderError(app.skipSeparator(remainingAfterSelected), "an inside name", err)
+	}
+
+	return ins, remaining[1:], nil
+}
+
+func (app *adapter) retrieveBetween(from Name, data []byte, isInclusive bool) (Selector, []byte, error) {
+	to, remainingAfterTo, err := app.retrieveElementName(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	builder := app.betweenBuilder.Create().WithFrom(from).WithTo(to)
+	if isInclusive {
+		builder.IsInclusive()
+	}
+
+	between, err := builder.Now()
+	if err != nil {
+		return nil, nil, app.builderError(data, "a name without selection, insides or index", err)
+	}
+
+	ins, err := app.builder.Create().WithBetween(between).Now()
+	if err != nil {
+		return nil, nil, app.builderError(data, "a selector", err)
+	}
+
+	return ins, remainingAfterTo, nil
+}
+
+func (app *adapter) retrieveSibling(name Name, data []byte) (Sibling, []byte, error) {
+	builder := app.siblingBuilder.Create().WithName(name)
+	remaining := data
+	if len(remaining) > 0 && remaining[0] == app.precedingByte {
+		builder.IsPreceding()
+		remaining = remaining[1:]
+	}
+
+	target, remainingAfterTarget, err := app.retrieveElementName(remaining)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ins, err := builder.WithTarget(target).Now()
+	if err != nil {
+		return nil, nil, app.builderError(remaining, "a target name", err)
+	}
+
+	return ins, remainingAfterTarget, nil
+}
+
+func (app *adapter) retrieveAncestor(name Name, data []byte) (Ancestor, []byte, error) {
+	builder := app.ancestorBuilder.Create().WithName(name)
+	remaining := data
+	if len(remaining) > 0 && remaining[0] == app.parentByte {
+		target, remainingAfterTarget, err := app.retrieveElementName(remaining[1:])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		builder.WithTarget(target)
+		remaining = remainingAfterTarget
+	}
+
+	ins, err := builder.Now()
+	if err != nil {
+		return nil, nil, app.builderError(data, "a target name", err)
+	}
+
+	return ins, remaining, nil
+}
+
+func (app *adapter) retrieveChannel(name Name, data []byte) (Channel, []byte, error) {
+	if !bytes.HasPrefix(data, app.channelKeyword) {
+		str := fmt.Sprintf("the axis was expecting the channel (%s) keyword, none provided", app.channelKeyword)
+		return nil, nil, app.syntaxError(data, string(app.channelKeyword), str)
+	}
+
+	remaining := data[len(app.channelKeyword):]
+	if len(remaining) <= 0 || remaining[0] != app.parametersPrefix {
+		str := fmt.Sprintf("the channel axis was expecting a parameters prefix byte (%d), none provided", app.parametersPrefix)
+		return nil, nil, app.syntaxError(remaining, string(app.parametersPrefix), str)
+	}
+
+	target, remainingAfterTarget, err := app.retrieveElementName(remaining[1:])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(remainingAfterTarget) <= 0 || remainingAfterTarget[0] != app.parametersSuffix {
+		str := fmt.Sprintf("the channel axis was expecting a parameters suffix byte (%d), none provided", app.parametersSuffix)
+		return nil, nil, app.syntaxError(remainingAfterTarget, string(app.parametersSuffix), str)
+	}
+
+	ins, err := app.channelBuilder.Create().WithName(name).WithTarget(target).Now()
+	if err != nil {
+		return nil, nil, app.builderError(remaining[1:], "a target name", err)
+	}
+
+	return ins, remainingAfterTarget[1:], nil
+}
+
+func (app *adapter) retrieveElementName(data []byte) (Name, []byte, error) {
+	isSelected, remainingAfterIsSelected := app.elementIsSelected(data)
+	insides, isChild, retAfterInsides, err := app.retrieveElementInsides(remainingAfterIsSelected)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tokenName, pattern, isLiteral, retAfterTokenName, err := app.retrieveTokenName(retAfterInsides, app.tokenNameByte)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	predicates, repetition, index, retAfterIndex, err := app.retrievePredicatesAndIndex(retAfterTokenName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nameBuilder := app.nameBuilder.Create()
+	if pattern != nil {
+		nameBuilder.WithPattern(pattern)
+	}
+
+	if tokenName != "" {
+		nameBuilder.WithName(tokenName)
+	}
+
+	if insides != nil {
+		nameBuilder.WithInsides(insides)
+	}
+
+	if isChild {
+		nameBuilder.IsChild()
+	}
+
+	if isLiteral {
+		nameBuilder.IsLiteral()
+	}
+
+	if index != nil {
+		nameBuilder.WithIndex(index)
+	}
+
+	if predicates != nil {
+		nameBuilder.WithPredicates(predicates)
+	}
+
+	if repetition != nil {
+		nameBuilder.WithRepetition(repetition)
+	}
+
+	if isSelected {
+		nameBuilder.IsSelected()
+	}
+
+	ins, err := nameBuilder.Now()
+	if err != nil {
+		return nil, nil, app.builderError(app.skipSeparator(remainingAfterIsSelected), "an inside name", err)
+	}
+
+	return ins, retAfterIndex, nil
+}
+
+func (app *adapter) retrievePredicatesAndIndex(data []byte) ([]Predicate, Repetition, Index, []byte, error) {
+	var index Index
+	var repetition Repetition
+	remaining := data
+	predicates := []Predicate{}
+	for {
+		if len(remaining) <= 0 {
+			break
+		}
+
+		if repetition == nil && app.isRepetition(remaining) {
+			retRepetition, remainingAfterRepetition, err := app.retrieveRepetition(remaining[1:])
+			if err != nil {
+				return nil, nil, nil, nil, err
+			}
+
+			repetition = retRepetition
+			remaining = remainingAfterRepetition
+			continue
+		}
+
+		// the axis prefix follows the name, it does not start a predicate:
+		if bytes.HasPrefix(remaining, app.axisPrefix) {
+			break
+		}
+
+		if remaining[0] == app.predicatePrefix {
+			predicate, remainingAfterPredicate, err := app.retrievePredicate(remaining[1:])
+			if err != nil {
+				return nil, nil, nil, nil, err
+			}
+
+			predicates = append(predicates, predicate)
+			remaining = remainingAfterPredicate
+			continue
+		}
+
+		if remaining[0] == app.alternativeByte {
+			predicate, remainingAfterAlternative, err := app.retrieveAlternative(remaining[1:])
+			if err != nil {
+				return nil, nil, nil, nil, err
+			}
+
+			predicates = append(predicates, predicate)
+			remaining = remainingAfterAlternative
+			continue
+		}
+
+		if app.isValue(remaining) {
+			value, remainingAfterValue, err := app.retrieveValue(remaining)
+			if err != nil {
+				return nil, nil, nil, nil, err
+			}
+
+			predicate, err := app.predicateBuilder.Create().WithValue(value).Now()
+			if err != nil {
+				return nil, nil, nil, nil, app.builderError(remaining, "a value", err)
+			}
+
+			predicates = append(predicates, predicate)
+			remaining = remainingAfterValue
+			continue
+		}
+
+		if index == nil && remaining[0] == app.indexPrefix {
+			retIndex, remainingAfterIndex, err := app.retrieveIndex(remaining)
+			if err != nil {
+				return nil, nil, nil, nil, err
+			}
+
+			index = retIndex
+			remaining = remainingAfterIndex
+			continue
+		}
+
+		break
+	}
+
+	return predicates, repetition, index, remaining, nil
+}
+
+func (app *adapter) isRepetition(data []byte) bool {
+	if len(data) <= 0 || data[0] != app.repetitionPrefix {
+		return false
+	}
+
+	length := bytes.IndexByte(data, app.repetitionSuffix)
+	if length <= 1 {
+		return false
+	}
+
+	content := data[1:length]
+	if len(content) == 1 && (content[0] == app.repetitionListByte || content[0] == app.repetitionAmountByte) {
+		return true
+	}
+
+	for _, oneByte := range content {
+		if oneByte == app.negativeByte || oneByte == app.sliceDelimiter {
+			continue
+		}
+
+		if oneByte < '0' || oneByte > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (app *adapter) retrieveRepetition(data []byte) (Repetition, []byte, error) {
+	builder := app.repetitionBuilder.Create()
+	remaining := data
+	if remaining[0] == app.repetitionListByte || remaining[0] == app.repetitionAmountByte {
+		if remaining[0] == app.repetitionListByte {
+			builder.IsList()
+		}
+
+		if remaining[0] == app.repetitionAmountByte {
+			builder.IsAmount()
+		}
+
+		remaining = remaining[2:]
+	}
+
+	if len(remaining) == len(data) {
+		index, remainingAfterIndex, err := app.retrieveIndexContent(remaining, app.repetitionSuffix)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		builder.WithIndex(index)
+		remaining = remainingAfterIndex
+	}
+
+	ins, err := builder.Now()
+	if err != nil {
+		return nil, nil, app.builderError(data, "a repetition", err)
+	}
+
+	return ins, remaining, nil
+}
+
+func (app *adapter) retrievePredicate(data []byte) (Predicate, []byte, error) {
+	if bytes.HasPrefix(data, app.alternativeKeyword) {
+		remaining := data[len(app.alternativeKeyword):]
+		if len(remaining) <= 0 || remaining[0] != app.parametersPrefix {
+			str := fmt.Sprintf("the alternative predicate was expecting a parameters prefix byte (%d), none provided", app.parametersPrefix)
+			return nil, nil, app.syntaxError(remaining, string(app.parametersPrefix), str)
+		}
+
+		predicate, remainingAfterAlternative, err := app.retrieveAlternative(remaining[1:])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if len(remainingAfterAlternative) <= 0 || remainingAfterAlternative[0] != app.parametersSuffix {
+			str := fmt.Sprintf("the alternative predicate was expecting a parameters suffix byte (%d), none provided", app.parametersSuffix)
+			return nil, nil, app.syntaxError(remainingAfterAlternative, string(app.parametersSuffix), str)
+		}
+
+		return predicate, remainingAfterAlternative[1:], nil
+	}
+
+	if !bytes.HasPrefix(data, app.notKeyword) {
+		str := fmt.Sprintf("the predicate was expecting the not (%s) or alternative (%s) keyword, none provided", app.notKeyword, app.alternativeKeyword)
+		return nil, nil, app.syntaxError(data, fmt.Sprintf("%s or %s", app.notKeyword, app.alternativeKeyword), str)
+	}
+
+	remaining := data[len(app.notKeyword):]
+	if len(remaining) <= 0 || remaining[0] != app.parametersPrefix {
+		str := fmt.Sprintf("the not predicate was expecting a parameters prefix byte (%d), none provided", app.parametersPrefix)
+		return nil, nil, app.syntaxError(remaining, string(app.parametersPrefix), str)
+	}
+
+	not, remainingAfterNot, err := app.retrieveSelector(remaining[1:])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(remainingAfterNot) <= 0 || remainingAfterNot[0] != app.parametersSuffix {
+		str := fmt.Sprintf("the not predicate was expecting a parameters suffix byte (%d), none provided", app.parametersSuffix)
+		return nil, nil, app.syntaxError(remainingAfterNot, string(app.parametersSuffix), str)
+	}
+
+	ins, err := app.predicateBuilder.Create().WithNot(not).Now()
+	if err != nil {
+		return nil, nil, app.builderError(remaining[1:], "a selector of unselected names", err)
+	}
+
+	return ins, remainingAfterNot[1:], nil
+}
+
+func (app *adapter) retrieveAlternative(data []byte) (Predicate, []byte, error) {
+	pAlternative, remainingAfterAlternative, err := utils.FetchNumber(data)
+	if err != nil {
+		str := fmt.Sprintf("the alternative predicate was expecting a number: %s", err.Error())
+		return nil, nil, app.syntaxError(data, "a number", str)
+	}
+
+	if *pAlternative <= 0 {
+		return nil, nil, app.syntaxError(data, "a number greater than 0", "the alternative predicate starts at 1, for the first alternative")
+	}
+
+	ins, err := app.predicateBuilder.Create().WithAlternative(*pAlternative).Now()
+	if err != nil {
+		return nil, nil, app.builderError(data, "a number greater than 0", err)
+	}
+
+	return ins, remainingAfterAlternative, nil
+}
+
+func (app *adapter) isValue(data []byte) bool {
+	if len(data) <= 0 || data[0] != app.indexPrefix {
+		return false
+	}
+
+	remaining := app.skipSeparator(data[1:])
+	if len(remaining) <= 0 {
+		return false
+	}
+
+	if remaining[0] == app.equalByte {
+		return true
+	}
+
+	isOperator := remaining[0] == app.prefixByte || remaining[0] == app.containsByte || remaining[0] == app.patternByte
+	return isOperator && len(remaining) > 1 && remaining[1] == app.equalByte
+}
+
+func (app *adapter) retrieveValue(data []byte) (Value, []byte, error) {
+	operator := app.equalByte
+	remaining := app.skipSeparator(data[1:])
+	if remaining[0] != app.equalByte {
+		operator = remaining[0]
+		remaining = remaining[1:]
+	}
+
+	builder := app.valueBuilder.Create()
+	remaining = remaining[1:]
+	if operator == app.patternByte {
+		if len(remaining) <= 0 || remaining[0] != app.patternDelimiter {
+			str := fmt.Sprintf("the value was expecting a pattern prefix byte (%d), none provided", app.patternDelimiter)
+			return nil, nil, app.syntaxError(remaining, string(app.patternDelimiter), str)
+		}
+
+		pattern, remainingAfterPattern, err := app.fetchPattern(remaining)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		builder.WithPattern(pattern)
+		remaining = remainingAfterPattern
+	}
+
+	if operator != app.patternByte {
+		content, remainingAfterContent, err := app.fetchString(remaining)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if operator == app.equalByte {
+			builder.WithEqual(content)
+		}
+
+		if operator == app.prefixByte {
+			builder.WithPrefix(content)
+		}
+
+		if operator == app.containsByte {
+			builder.WithContains(content)
+		}
+
+		remaining = remainingAfterContent
+	}
+
+	if len(remaining) <= 0 || remaining[0] != app.indexSuffix {
+		str := fmt.Sprintf("the value was expecting a suffix byte (%d), none provided", app.indexSuffix)
+		return nil, nil, app.syntaxError(remaining, string(app.indexSuffix), str)
+	}
+
+	ins, err := builder.Now()
+	if err != nil {
+		return nil, nil, app.builderError(data, "a value", err)
+	}
+
+	return ins, remaining[1:], nil
+}
+
+func (app *adapter) fetchString(input []byte) ([]byte, []byte, error) {
+	if len(input) <= 0 || input[0] != app.quoteByte {
+		str := fmt.Sprintf("the string was expecting a prefix byte (%d), none provided", app.quoteByte)
+		return nil, nil, app.syntaxError(input, string(app.quoteByte), str)
+	}
+
+	length, err := app.fetchStringLength(input)
+	if err != nil {
+		return nil, nil, app.syntaxError(input, string(app.quoteByte), err.Error())
+	}
+
+	content := []byte{}
+	for idx := 1; idx < length-1; idx++ {
+		if input[idx] == app.escapeByte {
+			idx++
+		}
+
+		content = append(content, input[idx])
+	}
+
+	return content, input[length:], nil
+}
+
+func (app *adapter) fetchStringLength(input []byte) (int, error) {
+	for idx := 1; idx < len(input); idx++ {
+		if input[idx] == app.escapeByte {
+			idx++
+			continue
+		}
+
+		if input[idx] == app.quoteByte {
+			return idx + 1, nil
+		}
+	}
+
+	str := fmt.Sprintf("the string was expecting a suffix byte (%d), none provided", app.quoteByte)
+	return 0, errors.New(str)
+}
+
+func (app *adapter) retrieveIndex(data []byte) (Index, []byte, error) {
+	if len(data) <= 0 || data[0] != app.indexPrefix {
+		return nil, data, nil
+	}
+
+	return app.retrieveIndexContent(data[1:], app.indexSuffix)
+}
+
+func (app *adapter) retrieveIndexContent(data []byte, suffix byte) (Index, []byte, error) {
+	builder := app.indexBuilder.Create()
+	pFrom, remainingAfterFrom, err := app.fetchIndexNumber(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	isSlice := false
+	remaining := remainingAfterFrom
+	if len(remaining) > 0 && remaining[0] == app.sliceDelimiter {
+		pTo, remainingAfterTo, err := app.fetchIndexNumber(remaining[1:])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		sliceBuilder := app.sliceBuilder.Create()
+		if pFrom != nil {
+			sliceBuilder.WithFrom(*pFrom)
+		}
+
+		if pTo != nil {
+			sliceBuilder.WithTo(*pTo)
+		}
+
+		slice, err := sliceBuilder.Now()
+		if err != nil {
+			return nil, nil, app.builderError(remaining, "a slice", err)
+		}
+
+		builder.WithSlice(slice)
+		remaining = remainingAfterTo
+		isSlice = true
+	}
+
+	if !isSlice && pFrom != nil {
+		builder.WithPosition(*pFrom)
+	}
+
+	if len(remaining) <= 0 || remaining[0] != suffix {
+		str := fmt.Sprintf("the index was expecting a suffix byte (%d), none provided", suffix)
+		return nil, nil, app.syntaxError(remaining, string(suffix), str)
+	}
+
+	ins, err := builder.Now()
+	if err != nil {
+		return nil, nil, app.builderError(data, "a position or a slice", err)
+	}
+
+	return ins, remaining[1:], nil
+}
+
+func (app *adapter) fetchIndexNumber(data []byte) (*int, []byte, error) {
+	isNegative := false
+	remaining := data
+	if len(remaining) > 0 && remaining[0] == app.negativeByte {
+		isNegative = true
+		remaining = remaining[1:]
+	}
+
+	pNumber, remainingAfterNumber, err := utils.FetchNumber(remaining)
+	if err != nil {
+		if isNegative {
+			str := fmt.Sprintf("the index was expecting a number after its negative byte (%d)", app.negativeByte)
+			return nil, nil, app.syntaxError(remaining, "a number", str)
+		}
+
+		return nil, data, nil
+	}
+
+	number := int(*pNumber)
+	if isNegative {
+		number = number * -1
+	}
+
+	return &number, remainingAfterNumber, nil
+}
+
+func (app *adapter) retrieveElementInsides(data []byte) ([]Inside, bool, []byte, error) {
+	remaining := data
+	insides := []Inside{}
+	for {
+		isChild, remainingAfterChild := app.elementIsChild(remaining)
+		if len(remainingAfterChild) <= 0 || remainingAfterChild[0] != app.insideByte {
+			return insides, isChild, remainingAfterChild, nil
+		}
+
+		tokenName, pattern, isLiteral, retAfterTokenName, err := app.retrieveTokenName(remainingAfterChild, app.insideByte)
+		if err != nil {
+			return nil, false, nil, err
+		}
+
+		builder := app.insideBuilder.Create()
+		if pattern != nil {
+			builder.WithPattern(pattern)
+		}
+
+		if tokenName != "" {
+			builder.WithName(tokenName)
+		}
+
+		if isChild {
+			builder.IsChild()
+		}
+
+		if isLiteral {
+			builder.IsLiteral()
+		}
+
+		inside, err := builder.Now()
+		if err != nil {
+			return nil, false, nil, app.builderError(remainingAfterChild, "an inside name", err)
+		}
+
+		insides = append(insides, inside)
+		remaining = retAfterTokenName
+	}
+}
+
+func (app *adapter) elementIsChild(data []byte) (bool, []byte) {
+	if len(data) <= 0 {
+		return false, data
+	}
+
+	if data[0] == app.childByte {
+		return true, data[1:]
+	}
+
+	return false, data
 }
 
-// ToSelector converts a script to selector
-func (app *adapter) ToSelector(script string) (Selector, []byte, error) {
-	// convert to bytes:
-	bytes := []byte(script)
+func (app *adapter) elementIsSelected(data []byte) (bool, []byte) {
+	if len(data) <= 0 {
+		return false, data
+	}
 
-	// remove channel characters:
-	remainingAfterChans := app.removeChannelCharacters(bytes)
+	if data[0] == app.selectByte {
+		return true, data[1:]
+	}
 
-	// retrieve the selector:
-	return app.retrieveSelector(remainingAfterChans)
+	return false, data
 }
 
-func (app *adapter) retrieveSelector(data []byte) (Selector, []byte, error) {
-	anyElement, remainingAfterAny, err := app.retrieveAnySelector(data)
-	if err == nil {
-		return anyElement, remainingAfterAny, nil
+func (app *adapter) retrieveTokenName(data []byte, prefixByte byte) (string, *regexp.Regexp, bool, []byte, error) {
+	if len(data) < 1 {
+		str := fmt.Sprintf("the tokenName was NOT expecting empty data")
+		return "", nil, false, nil, app.syntaxError(data, "a token name", str)
 	}
 
-	name, remainingAfterName, err := app.retrieveElementName(data)
-	if err != nil {
-		return nil, nil, err
+	if data[0] == prefixByte {
+		remaining := app.skipSeparator(data[1:])
+		if len(remaining) > 0 && remaining[0] == app.patternDelimiter {
+			pattern, remainingAfterPattern, err := app.fetchPattern(remaining)
+			if err != nil {
+				return "", nil, false, nil, err
+			}
+
+			return "", pattern, false, remainingAfterPattern, nil
+		}
+
+		if len(remaining) > 0 && remaining[0] == app.quoteByte {
+			tokenName, remainingAfterTokenName, err := app.fetchQuotedTokenName(remaining)
+			if err != nil {
+				return "", nil, false, nil, err
+			}
+
+			// the wildcards and alternatives of a quoted name are not special, so it is matched literally:
+			return tokenName, nil, app.isGlob([]byte(tokenName)), remainingAfterTokenName, nil
+		}
+
+		tokenName, remainingAfterTokenName, err := app.fetchTokenNamePattern(remaining, prefixByte == app.tokenNameByte)
+		if err != nil {
+			return "", nil, false, nil, err
+		}
+
+		return tokenName, nil, false, remainingAfterTokenName, nil
 	}
 
-	ins, err := app.builder.Create().WithName(name).Now()
+	str := fmt.Sprintf("the tokenName was expecting a prefix byte (%d), none provided", prefixByte)
+	return "", nil, false, nil, app.syntaxError(data, string(prefixByte), str)
+}
+
+// fetchQuotedTokenName fetches a token name written as a string, whose content is matched literally
+func (app *adapter) fetchQuotedTokenName(input []byte) (string, []byte, error) {
+	content, remaining, err := app.fetchString(input)
 	if err != nil {
-		return nil, nil, err
+		return "", nil, err
 	}
 
-	return ins, remainingAfterName, nil
+	if len(content) <= 0 {
+		return "", nil, app.syntaxError(input, "a token name", "the tokenName must contain at least 1 character, none provided")
+	}
+
+	return string(content), remaining, nil
 }
 
-func (app *adapter) retrieveElementName(data []byte) (Name, []byte, error) {
-	isSelected, remainingAfterIsSelected := app.elementIsSelected(data)
-	insideNames, retAfterInsideNames := app.retrieveElementInsideNames(remainingAfterIsSelected)
-	tokenName, retAfterTokenName, err := app.retrieveTokenName(retAfterInsideNames, app.tokenNameByte)
+// isGlob returns true if the name contains wildcards or alternatives, false otherwise
+func (app *adapter) isGlob(name []byte) bool {
+	return bytes.ContainsAny(name, string(app.wildcardCharacters)) || bytes.ContainsAny(name, string([]byte{app.alternativesPrefix, app.alternativesSuffix}))
+}
+
+func (app *adapter) fetchPattern(input []byte) (*regexp.Regexp, []byte, error) {
+	length, err := app.fetchPatternLength(input)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, app.syntaxError(input, string(app.patternDelimiter), err.Error())
 	}
 
-	nameBuilder := app.nameBuilder.Create().WithName(tokenName)
-	if insideNames != nil {
-		nameBuilder.WithInsideNames(insideNames)
+	pattern, err := regexp.Compile(string(input[1 : length-1]))
+	if err != nil {
+		return nil, nil, app.syntaxError(input, "a valid pattern", err.Error())
 	}
 
-	if isSelected {
-		nameBuilder.IsSelected()
+	return pattern, input[length:], nil
+}
+
+func (app *adapter) fetchPatternLength(input []byte) (int, error) {
+	for idx := 1; idx < len(input); idx++ {
+		if input[idx] == app.escapeByte {
+			idx++
+			continue
+		}
+
+		if input[idx] == app.patternDelimiter {
+			return idx + 1, nil
+		}
 	}
 
-	ins, err := nameBuilder.Now()
+	str := fmt.Sprintf("the pattern was expecting a suffix byte (%d), none provided", app.patternDelimiter)
+	return 0, errors.New(str)
+}
+
+// tokenize returns the script without its channel characters and comments, the offset of each returned byte in the script, and the comments.
+// The channel characters separate the lexemes of the script, unless the adapter is compatible with the previous versions
+func (app *adapter) tokenize(input []byte) ([]byte, []int, []*lexeme, error) {
+	if app.isCompatible {
+		return app.removeChannelCharacters(input)
+	}
+
+	lexemes, comments, err := app.lexemes(input)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
-	return ins, retAfterTokenName, nil
-}
+	output := []byte{}
+	positions := []int{}
+	for idx, oneLexeme := range lexemes {
+		if idx > 0 && lexemes[idx-1].end() < oneLexeme.offset {
+			previous := lexemes[idx-1]
+			if app.isOperator(append(append([]byte{}, previous.data...), oneLexeme.data[0])) || app.isSignedNumber(previous.data, oneLexeme.data) {
+				return nil, nil, nil, app.parseError(input, previous.end(), "no channel character", "the channel characters cannot appear inside a lexeme")
+			}
 
-func (app *adapter) retrieveElementInsideNames(data []byte) ([]string, []byte) {
-	if len(data) <= 0 {
-		return nil, data
+			// keep a separator between two words, and between a token name and the any byte:
+			isWord := app.isWordByte(previous.data[len(previous.data)-1]) || utils.IsBytePresent(previous.data[len(previous.data)-1], app.wildcardCharacters)
+			if (isWord && app.isWordByte(oneLexeme.data[0])) || oneLexeme.data[0] == app.anyByte {
+				output = append(output, app.separatorByte)
+				positions = append(positions, previous.end())
+			}
+		}
+
+		output = append(output, oneLexeme.data...)
+		positions = append(positions, app.offsets(oneLexeme.offset, len(oneLexeme.data))...)
 	}
 
-	remaining := data
-	names := []string{}
-	for {
-		if remaining[0] != app.insideByte {
-			break
+	return output, positions, comments, nil
+}
+
+func (app *adapter) lexemes(input []byte) ([]*lexeme, []*lexeme, error) {
+	output := []*lexeme{}
+	comments := []*lexeme{}
+	idx := 0
+	for idx < len(input) {
+		if utils.IsBytePresent(input[idx], app.channelCharacters) {
+			idx++
+			continue
+		}
+
+		// a pattern value can start like a comment:
+		isValuePattern := len(output) > 0 && bytes.Equal(output[len(output)-1].data, []byte{app.patternByte, app.equalByte})
+		if !isValuePattern {
+			commentLength, err := app.commentLength(input, idx)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if commentLength > 0 {
+				comments = append(comments, createLexeme(input[idx:idx+commentLength], idx))
+				idx += commentLength
+				continue
+			}
 		}
 
-		tokenName, retAfterTokenName, err := app.retrieveTokenName(remaining, app.insideByte)
+		length, err := app.lexemeLength(input, idx, output)
 		if err != nil {
-			break
+			return nil, nil, err
 		}
 
-		names = append(names, tokenName)
-		remaining = retAfterTokenName
+		output = append(output, createLexeme(input[idx:idx+length], idx))
+		idx += length
 	}
 
-	return names, remaining
+	return output, comments, nil
 }
 
-func (app *adapter) elementIsSelected(data []byte) (bool, []byte) {
-	if len(data) <= 0 {
-		return false, data
+// commentLength returns the length of the line or block comment located at the index, or 0 if there is none
+func (app *adapter) commentLength(input []byte, index int) (int, error) {
+	data := input[index:]
+	if bytes.HasPrefix(data, app.lineCommentPrefix) {
+		length := bytes.IndexByte(data, app.statementDelimiter)
+		if length < 0 {
+			return len(data), nil
+		}
+
+		return length, nil
 	}
 
-	if data[0] == app.selectByte {
-		return true, data[1:]
+	if !bytes.HasPrefix(data, app.blockCommentPrefix) {
+		return 0, nil
 	}
 
-	return false, data
+	length := bytes.Index(data[len(app.blockCommentPrefix):], app.blockCommentSuffix)
+	if length < 0 {
+		str := fmt.Sprintf("the block comment was expecting a suffix (%s), none provided", app.blockCommentSuffix)
+		return 0, app.parseError(input, len(input), string(app.blockCommentSuffix), str)
+	}
+
+	return len(app.blockCommentPrefix) + length + len(app.blockCommentSuffix), nil
 }
 
-func (app *adapter) retrieveAnySelector(data []byte) (Selector, []byte, error) {
-	isAny := false
-	prefixData := []byte{}
-	for idx, value := range data {
-		if value != app.anyByte {
-			continue
+func (app *adapter) lexemeLength(input []byte, index int, previous []*lexeme) (int, error) {
+	data := input[index:]
+	if data[0] == app.quoteByte {
+		length, err := app.fetchStringLength(data)
+		if err != nil {
+			str := fmt.Sprintf("the string is invalid: %s", err.Error())
+			return 0, app.parseError(input, index, "a valid string", str)
 		}
 
-		isAny = true
-		prefixData = data[0:idx]
-		break
+		return length, nil
 	}
 
-	if !isAny {
-		return nil, nil, errors.New("the given data does not represent an AnyElement instance")
+	isValuePattern := len(previous) > 0 && bytes.Equal(previous[len(previous)-1].data, []byte{app.patternByte, app.equalByte})
+	if data[0] == app.patternDelimiter && isValuePattern {
+		return app.patternLexemeLength(input, index)
 	}
 
-	elementBuilder := app.builder.Create()
-	remainingAfterPrefix := prefixData
-	if len(prefixData) > 0 {
-		prefix, remaining, err := app.retrieveElementName(prefixData)
+	if bytes.HasPrefix(data, app.rangeBytes) {
+		return len(app.rangeBytes), nil
+	}
+
+	if data[0] == app.tokenNameByte || data[0] == app.insideByte {
+		length, err := app.nameLength(input, index+1, data[0] == app.tokenNameByte)
 		if err != nil {
-			return nil, nil, err
+			return 0, err
+		}
+
+		if length <= 0 {
+			return 0, app.parseError(input, index+1, "a token name", "the token name must directly follow its prefix")
 		}
 
-		remainingAfterPrefix = remaining
-		elementBuilder.WithAny(prefix)
+		return length + 1, nil
 	}
 
-	ins, err := elementBuilder.Now()
-	if err != nil {
-		return nil, nil, err
+	if app.isWordByte(data[0]) {
+		length := 1
+		for length < len(data) && app.isWordByte(data[length]) {
+			length++
+		}
+
+		return length, nil
+	}
+
+	if len(data) > 1 && app.isOperator(data[:2]) {
+		return 2, nil
 	}
 
-	return ins, remainingAfterPrefix, nil
+	return 1, nil
 }
 
-func (app *adapter) retrieveTokenName(data []byte, prefixByte byte) (string, []byte, error) {
-	if len(data) < 1 {
-		str := fmt.Sprintf("the tokenName was NOT expecting empty data")
-		return "", nil, errors.New(str)
+// nameLength returns the length of the token name, wildcards, alternatives or pattern located at the index
+func (app *adapter) nameLength(input []byte, index int, isName bool) (int, error) {
+	data := input[index:]
+	if len(data) > 0 && data[0] == app.patternDelimiter {
+		return app.patternLexemeLength(input, index)
 	}
 
-	if data[0] == prefixByte {
-		tokenName, remainingAfterTokenName, err := app.fetchTokenName(data[1:])
+	if len(data) > 0 && data[0] == app.quoteByte {
+		length, err := app.fetchStringLength(data)
 		if err != nil {
-			return "", nil, err
+			str := fmt.Sprintf("the string is invalid: %s", err.Error())
+			return 0, app.parseError(input, index, "a valid string", str)
 		}
 
-		return tokenName, remainingAfterTokenName, nil
+		return length, nil
 	}
 
-	str := fmt.Sprintf("the tokenName was expecting a prefix byte (%d), none provided", prefixByte)
-	return "", nil, errors.New(str)
+	amountOpen := 0
+	length := 0
+	for length < len(data) {
+		oneByte := data[length]
+		if amountOpen <= 0 && app.isRepetitionLexeme(data[length:]) {
+			break
+		}
+
+		if isName && amountOpen <= 0 && length > 0 && app.isAnyAfterName(data[length:]) {
+			break
+		}
+
+		isAlternatives := oneByte == app.alternativesPrefix || (amountOpen > 0 && (oneByte == app.alternativesSuffix || oneByte == app.alternativesDelimiter))
+		if !isAlternatives && !app.isWordByte(oneByte) && !utils.IsBytePresent(oneByte, app.wildcardCharacters) {
+			break
+		}
+
+		if oneByte == app.alternativesPrefix {
+			amountOpen++
+		}
+
+		if amountOpen > 0 && oneByte == app.alternativesSuffix {
+			amountOpen--
+		}
+
+		length++
+	}
+
+	return length, nil
+}
+
+func (app *adapter) patternLexemeLength(input []byte, index int) (int, error) {
+	length, err := app.fetchPatternLength(input[index:])
+	if err == nil {
+		_, err = regexp.Compile(string(input[index+1 : index+length-1]))
+	}
+
+	if err != nil {
+		str := fmt.Sprintf("the pattern is invalid: %s", err.Error())
+		return 0, app.parseError(input, index, "a valid pattern", str)
+	}
+
+	return length, nil
+}
+
+// isRepetitionLexeme returns true if the data starts with a repetition, whose lexemes can be separated by channel characters
+func (app *adapter) isRepetitionLexeme(data []byte) bool {
+	if len(data) <= 0 || data[0] != app.repetitionPrefix {
+		return false
+	}
+
+	length := bytes.IndexByte(data, app.repetitionSuffix)
+	if length <= 1 {
+		return false
+	}
+
+	content := []byte{}
+	for _, oneByte := range data[1:length] {
+		if utils.IsBytePresent(oneByte, app.channelCharacters) {
+			continue
+		}
+
+		content = append(content, oneByte)
+	}
+
+	return app.isRepetition(append(append([]byte{app.repetitionPrefix}, content...), app.repetitionSuffix))
+}
+
+func (app *adapter) isOperator(data []byte) bool {
+	operators := [][]byte{
+		app.rangeBytes,
+		app.axisPrefix,
+		{app.siblingByte, app.precedingByte},
+		{app.parentByte, app.parentByte},
+		{app.prefixByte, app.equalByte},
+		{app.containsByte, app.equalByte},
+		{app.patternByte, app.equalByte},
+	}
+
+	for _, oneOperator := range operators {
+		if bytes.Equal(data, oneOperator) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isSignedNumber returns true if the lexemes are a negative or alternative byte followed by a number
+func (app *adapter) isSignedNumber(first []byte, second []byte) bool {
+	if len(first) != 1 || (first[0] != app.negativeByte && first[0] != app.alternativeByte) {
+		return false
+	}
+
+	return second[0] >= '0' && second[0] <= '9'
+}
+
+func (app *adapter) isWordByte(value byte) bool {
+	return app.isTokenNameByte(value, false) || (value >= '0' && value <= '9')
+}
+
+// isTokenNameByte returns true if the byte can be used in a token name, at its first position or after it
+func (app *adapter) isTokenNameByte(value byte, isFirst bool) bool {
+	if utils.IsBytePresent(value, app.tokenNameCharacters) {
+		return true
+	}
+
+	return !isFirst && utils.IsBytePresent(value, app.tokenNameFollowing)
 }
 
-func (app *adapter) removeChannelCharacters(input []byte) []byte {
+// removeChannelCharacters returns the input without its channel characters, and the offset of each returned byte in the input
+func (app *adapter) removeChannelCharacters(input []byte) ([]byte, []int, []*lexeme, error) {
 	output := []byte{}
-	for _, oneInputByte := range input {
+	positions := []int{}
+	comments := []*lexeme{}
+	for idx := 0; idx < len(input); idx++ {
+		oneInputByte := input[idx]
+
+		// keep the patterns untouched, and validate them while their position is still known:
+		isPattern := oneInputByte == app.patternDelimiter && len(output) > 0 && (output[len(output)-1] == app.tokenNameByte || output[len(output)-1] == app.insideByte || output[len(output)-1] == app.equalByte)
+		if isPattern {
+			length, err := app.fetchPatternLength(input[idx:])
+			if err == nil {
+				_, err = regexp.Compile(string(input[idx+1 : idx+length-1]))
+			}
+
+			if err != nil {
+				str := fmt.Sprintf("the pattern is invalid: %s", err.Error())
+				return nil, nil, nil, app.parseError(input, idx, "a valid pattern", str)
+			}
+
+			output = append(output, input[idx:idx+length]...)
+			positions = append(positions, app.offsets(idx, length)...)
+			idx += length - 1
+			continue
+		}
+
+		// keep the strings untouched:
+		if oneInputByte == app.quoteByte {
+			length, err := app.fetchStringLength(input[idx:])
+			if err != nil {
+				str := fmt.Sprintf("the string is invalid: %s", err.Error())
+				return nil, nil, nil, app.parseError(input, idx, "a valid string", str)
+			}
+
+			output = append(output, input[idx:idx+length]...)
+			positions = append(positions, app.offsets(idx, length)...)
+			idx += length - 1
+			continue
+		}
+
+		commentLength, err := app.commentLength(input, idx)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		if commentLength > 0 {
+			comments = append(comments, createLexeme(input[idx:idx+commentLength], idx))
+			idx += commentLength - 1
+			continue
+		}
+
 		if utils.IsBytePresent(oneInputByte, app.channelCharacters) {
+			// keep a separator between a token name and the any byte, to differentiate it from a wildcard:
+			isLast := idx+1 >= len(input) || !utils.IsBytePresent(input[idx+1], app.channelCharacters)
+			if isLast && idx+1 < len(input) && input[idx+1] == app.anyByte {
+				output = append(output, app.separatorByte)
+				positions = append(positions, idx)
+			}
+
 			continue
 		}
 
 		output = append(output, oneInputByte)
+		positions = append(positions, idx)
+	}
+
+	return output, positions, comments, nil
+}
+
+func (app *adapter) offsets(index int, length int) []int {
+	output := []int{}
+	for idx := 0; idx < length; idx++ {
+		output = append(output, index+idx)
 	}
 
 	return output
 }
 
+// toParseError converts a syntax error on the data, stripped from the script's channel characters, to a parse error on the script
+func (app *adapter) toParseError(script []byte, data []byte, positions []int, err error) error {
+	var syntax *syntaxError
+	if !errors.As(err, &syntax) {
+		return err
+	}
+
+	offset := 0
+	index := len(data) - syntax.remaining
+	if index < len(positions) {
+		offset = positions[index]
+	}
+
+	if index >= len(positions) && len(positions) > 0 {
+		offset = positions[len(positions)-1] + 1
+	}
+
+	return app.parseError(script, offset, syntax.expected, syntax.message)
+}
+
+func (app *adapter) parseError(script []byte, offset int, expected string, message string) ParseError {
+	line, column := app.position(script, offset)
+	found := "the end of the script"
+	if offset < len(script) {
+		length := 1
+		for offset+length < len(script) && !utils.IsBytePresent(script[offset+length], app.channelCharacters) {
+			length++
+		}
+
+		found = string(script[offset : offset+length])
+	}
+
+	start := bytes.LastIndexByte(script[:offset], app.statementDelimiter) + 1
+	end := bytes.IndexByte(script[offset:], app.statementDelimiter)
+	source := script[start:]
+	if end >= 0 {
+		source = script[start : offset+end]
+	}
+
+	return createParseError(message, uint(line), uint(column), uint(offset), expected, found, string(source))
+}
+
+func (app *adapter) syntaxError(data []byte, expected string, message string) error {
+	return createSyntaxError(message, expected, len(data))
+}
+
+// builderError positions the error returned by a builder at the start of the data of the construct it was building
+func (app *adapter) builderError(data []byte, expected string, err error) error {
+	return app.syntaxError(data, expected, err.Error())
+}
+
+// positionError positions the error at the start of the data, unless it is already positioned
+func (app *adapter) positionError(data []byte, err error) error {
+	var syntax *syntaxError
+	if errors.As(err, &syntax) {
+		return err
+	}
+
+	return createSyntaxError(err.Error(), "", len(data))
+}
+
+func (app *adapter) position(input []byte, index int) (int, int) {
+	line := 1
+	column := 1
+	for _, oneInputByte := range input[:index] {
+		if oneInputByte == app.statementDelimiter {
+			line++
+			column = 1
+			continue
+		}
+
+		column++
+	}
+
+	return line, column
+}
+
+func (app *adapter) skipSeparator(input []byte) []byte {
+	if len(input) > 0 && input[0] == app.separatorByte {
+		return input[1:]
+	}
+
+	return input
+}
+
+func (app *adapter) fetchTokenNamePattern(input []byte, isName bool) (string, []byte, error) {
+	amountOpen := 0
+	nameBytes := []byte{}
+	for idx, oneInputByte := range input {
+		if amountOpen <= 0 && app.isRepetition(input[idx:]) {
+			break
+		}
+
+		if isName && amountOpen <= 0 && len(nameBytes) > 0 && app.isAnyAfterName(input[idx:]) {
+			break
+		}
+
+		if oneInputByte == app.alternativesPrefix {
+			amountOpen++
+			nameBytes = append(nameBytes, oneInputByte)
+			continue
+		}
+
+		if amountOpen > 0 && oneInputByte == app.alternativesSuffix {
+			amountOpen--
+			nameBytes = append(nameBytes, oneInputByte)
+			continue
+		}
+
+		if amountOpen > 0 && oneInputByte == app.alternativesDelimiter {
+			nameBytes = append(nameBytes, oneInputByte)
+			continue
+		}
+
+		if !app.isTokenNameByte(oneInputByte, idx <= 0) && !utils.IsBytePresent(oneInputByte, app.wildcardCharacters) {
+			break
+		}
+
+		nameBytes = append(nameBytes, oneInputByte)
+	}
+
+	if amountOpen > 0 {
+		str := fmt.Sprintf("the tokenName (%s) was expecting %d alternatives suffix byte (%d), none provided", nameBytes, amountOpen, app.alternativesSuffix)
+		return "", nil, app.syntaxError(input[len(nameBytes):], string(app.alternativesSuffix), str)
+	}
+
+	if len(nameBytes) <= 0 {
+		return "", nil, app.syntaxError(input, "a token name", "the tokenName must contain at least 1 character, none provided")
+	}
+
+	return string(nameBytes), input[len(nameBytes):], nil
+}
+
+// isAnyAfterName returns true if the data starts with an any byte that ends a selected token name, which is then the any
+// selector following the name instead of a wildcard, as in the previous versions where the channel characters were removed
+func (app *adapter) isAnyAfterName(data []byte) bool {
+	if !app.isCompatible || len(data) <= 0 || data[0] != app.anyByte {
+		return false
+	}
+
+	if len(data) == 1 {
+		return true
+	}
+
+	next := data[1:]
+	if utils.IsBytePresent(next[0], app.channelCharacters) || bytes.HasPrefix(next, app.lineCommentPrefix) || bytes.HasPrefix(next, app.blockCommentPrefix) {
+		return true
+	}
+
+	return next[0] == app.tokenNameByte || next[0] == app.unionByte || next[0] == app.statementSuffix || next[0] == app.parametersSuffix
+}
+
 func (app *adapter) fetchTokenName(input []byte) (string, []byte, error) {
 	nameBytes := []byte{}
-	for _, oneInputByte := range input {
-		if !utils.IsBytePresent(oneInputByte, app.tokenNameCharacters) {
+	for idx, oneInputByte := range input {
+		if !app.isTokenNameByte(oneInputByte, idx <= 0) {
 			break
 		}
 
@@ -219,7 +2160,7 @@ func (app *adapter) fetchTokenName(input []byte) (string, []byte, error) {
 	}
 
 	if len(nameBytes) <= 0 {
-		return "", nil, errors.New("the tokenName must contain at least 1 character, none provided")
+		return "", nil, app.syntaxError(input, "a token name", "the tokenName must contain at least 1 character, none provided")
 	}
 
 	return string(nameBytes), input[len(nameBytes):], nil
@@ -1,6 +1,11 @@
 package selectors
 
-import "testing"
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
 
 func TestSelectorAdapter_isName_isNotSelected_Success(t *testing.T) {
 	script := `
@@ -189,3 +194,1454 @@ func TestSelectorAdapter_isAny_withPrefix_isNOTSelect_Success(t *testing.T) {
 		return
 	}
 }
+
+func TestSelectorAdapter_isName_withPosition_Success(t *testing.T) {
+	script := `
+		+ @rootToken .five[-1]
+	`
+
+	adapter := NewAdapter()
+	selector, _, err := adapter.ToSelector(script)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	name := selector.Name()
+	if !name.HasIndex() {
+		t.Errorf("the name was expected to contain an index")
+		return
+	}
+
+	index := name.Index()
+	if !index.IsPosition() {
+		t.Errorf("the index was expected to contain a position")
+		return
+	}
+
+	if *index.Position() != -1 {
+		t.Errorf("the position was expected to be %d, %d returned", -1, *index.Position())
+		return
+	}
+}
+
+func TestSelectorAdapter_isName_withSlice_Success(t *testing.T) {
+	script := `
+		+ @list .item[2:5]
+	`
+
+	adapter := NewAdapter()
+	selector, _, err := adapter.ToSelector(script)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	index := selector.Name().Index()
+	if !index.IsSlice() {
+		t.Errorf("the index was expected to contain a slice")
+		return
+	}
+
+	slice := index.Slice()
+	if !slice.HasFrom() || *slice.From() != 2 {
+		t.Errorf("the slice was expected to start at %d", 2)
+		return
+	}
+
+	if !slice.HasTo() || *slice.To() != 5 {
+		t.Errorf("the slice was expected to end at %d", 5)
+		return
+	}
+}
+
+func TestSelectorAdapter_isName_withInvalidIndex_returnsError(t *testing.T) {
+	scripts := []string{
+		".five[]",
+		".five[1",
+		".five[-]",
+		".five[a]",
+	}
+
+	adapter := NewAdapter()
+	for _, oneScript := range scripts {
+		_, _, err := adapter.ToSelector(oneScript)
+		if err == nil {
+			t.Errorf("the error was expected to be valid, nil returned (script: %s)", oneScript)
+			return
+		}
+	}
+}
+
+func TestSelectorAdapter_isUnion_Success(t *testing.T) {
+	script := `
+		+ .five , + @rootToken .smallerThan *
+	`
+
+	adapter := NewAdapter()
+	selector, _, err := adapter.ToSelector(script)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !selector.IsUnion() {
+		t.Errorf("the element was expected to contain a union")
+		return
+	}
+
+	union := selector.Union()
+	if len(union) != 2 {
+		t.Errorf("%d selectors were expected in the union, %d returned", 2, len(union))
+		return
+	}
+
+	if !union[0].IsName() || union[0].Name().Name() != "five" {
+		t.Errorf("the first selector of the union was expected to be the name '%s'", "five")
+		return
+	}
+
+	if !union[1].IsAny() || union[1].Any().Name() != "smallerThan" {
+		t.Errorf("the second selector of the union was expected to be the any '%s'", "smallerThan")
+		return
+	}
+}
+
+func TestSelectorAdapter_isUnion_withMissingSelector_returnsError(t *testing.T) {
+	script := `
+		+ .five ,
+	`
+
+	adapter := NewAdapter()
+	_, _, err := adapter.ToSelector(script)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestSelectorAdapter_withStatements_Success(t *testing.T) {
+	script := `
+		lhs = + @rootToken .five[0];
+		op = + .smallerThan;
+	`
+
+	adapter := NewAdapter()
+	statements, _, err := adapter.ToStatements(script)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	list := statements.List()
+	if len(list) != 2 {
+		t.Errorf("%d statements were expected, %d returned", 2, len(list))
+		return
+	}
+
+	if list[0].Name() != "lhs" {
+		t.Errorf("the first statement was expected to be named '%s', '%s' returned", "lhs", list[0].Name())
+		return
+	}
+
+	op, err := statements.Fetch("op")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if op.Selector().Name().Name() != "smallerThan" {
+		t.Errorf("the op statement was expected to select '%s', '%s' returned", "smallerThan", op.Selector().Name().Name())
+		return
+	}
+
+	expected := "lhs = + @rootToken .five[0];\nop = + .smallerThan;"
+	retScript := adapter.StatementsToScript(statements)
+	if string(retScript) != expected {
+		t.Errorf("the script was expected to be '%s', '%s' returned", expected, retScript)
+		return
+	}
+}
+
+func TestSelectorAdapter_withStatements_withDuplicateName_returnsError(t *testing.T) {
+	script := `
+		lhs = + .five;
+		lhs = + .smallerThan;
+	`
+
+	adapter := NewAdapter()
+	_, _, err := adapter.ToStatements(script)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	var parseErr ParseError
+	if !errors.As(err, &parseErr) {
+		t.Errorf("the error was expected to be a ParseError")
+		return
+	}
+
+	if parseErr.Line() != 3 || parseErr.Column() != 3 {
+		t.Errorf("the error was expected at the line 3, column 3, line %d, column %d returned", parseErr.Line(), parseErr.Column())
+		return
+	}
+}
+
+func TestSelectorAdapter_withStatements_withoutSuffix_returnsError(t *testing.T) {
+	script := `
+		lhs = + .five
+	`
+
+	adapter := NewAdapter()
+	_, _, err := adapter.ToStatements(script)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestSelectorAdapter_isName_withDirectChild_Success(t *testing.T) {
+	script := `
+		+ @rootToken > @group @expression > .five
+	`
+
+	adapter := NewAdapter()
+	selector, _, err := adapter.ToSelector(script)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	name := selector.Name()
+	if !name.IsChild() {
+		t.Errorf("the name was expected to be a direct child")
+		return
+	}
+
+	insides := name.Insides()
+	if len(insides) != 3 {
+		t.Errorf("%d insides were expected, %d returned", 3, len(insides))
+		return
+	}
+
+	expected := []bool{
+		false,
+		true,
+		false,
+	}
+
+	for idx, oneInside := range insides {
+		if oneInside.IsChild() != expected[idx] {
+			t.Errorf("the inside (name: %s) was expected to have its direct child flag set to %t", oneInside.Name(), expected[idx])
+			return
+		}
+	}
+}
+
+func TestSelectorAdapter_isName_withDirectChild_withoutInsides_returnsError(t *testing.T) {
+	scripts := []string{
+		"+ > .five",
+		"+ > @rootToken .five",
+	}
+
+	adapter := NewAdapter()
+	for _, oneScript := range scripts {
+		_, _, err := adapter.ToSelector(oneScript)
+		if err == nil {
+			t.Errorf("the error was expected to be valid, nil returned (script: %s)", oneScript)
+			return
+		}
+	}
+}
+
+func TestSelectorAdapter_isName_withWildcards_Success(t *testing.T) {
+	scripts := map[string]string{
+		".*":            "*",
+		".num*":         "num*",
+		".{num*}":       "{num*}",
+		".{five,six}":   "{five,six}",
+		".{ five, six}": "{five,six}",
+		".n?m*":         "n?m*",
+		".n?m*[0]":      "n?m*",
+	}
+
+	adapter := NewAdapter()
+	for script, expected := range scripts {
+		selector, _, err := adapter.ToSelector(script)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if !selector.IsName() {
+			t.Errorf("the element was expected to contain a name (script: %s)", script)
+			return
+		}
+
+		name := selector.Name().Name()
+		if name != expected {
+			t.Errorf("the name was expected to be '%s', '%s' returned", expected, name)
+			return
+		}
+	}
+}
+
+func TestSelectorAdapter_isAny_withWildcardName_Success(t *testing.T) {
+	script := `
+		+ @root* .num* *
+	`
+
+	adapter := NewAdapter()
+	selector, _, err := adapter.ToSelector(script)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !selector.IsAny() {
+		t.Errorf("the element was expected to contain an Any")
+		return
+	}
+
+	any := selector.Any()
+	if any.Name() != "num*" {
+		t.Errorf("the prefix was expected to be '%s', '%s' returned", "num*", any.Name())
+		return
+	}
+
+	if any.InsideNames()[0] != "root*" {
+		t.Errorf("the insideName was expected to be '%s', '%s' returned", "root*", any.InsideNames()[0])
+		return
+	}
+}
+
+func TestSelectorAdapter_isAny_withAnyAfterName_Success(t *testing.T) {
+	scripts := map[string]Adapter{
+		"+ @root* .num *":          NewAdapter(),
+		"+ @root* .num * ,+ .five": NewAdapter(),
+		"+ @root* .num*":           NewCompatibilityAdapter(),
+		"+ @root* .num* ,+ .five":  NewCompatibilityAdapter(),
+	}
+
+	for oneScript, adapter := range scripts {
+		selector, _, err := adapter.ToSelector(oneScript)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s (script: %s)", err.Error(), oneScript)
+			return
+		}
+
+		if selector.IsUnion() {
+			selector = selector.Union()[0]
+		}
+
+		if !selector.IsAny() {
+			t.Errorf("the element was expected to contain an Any (script: %s)", oneScript)
+			return
+		}
+
+		if selector.Any().Name() != "num" {
+			t.Errorf("the prefix was expected to be '%s', '%s' returned (script: %s)", "num", selector.Any().Name(), oneScript)
+			return
+		}
+	}
+}
+
+func TestSelectorAdapter_toScript_withWildcardEndingName_Success(t *testing.T) {
+	name, err := NewNameBuilder().Create().IsSelected().WithName("num*").Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	selector, err := NewBuilder().Create().WithName(name).Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := "+ .num*"
+	script := NewAdapter().ToScript(selector)
+	if string(script) != expected {
+		t.Errorf("the script was expected to be '%s', '%s' returned", expected, script)
+		return
+	}
+
+	expected = "+ .{num*}"
+	script = NewCompatibilityAdapter().ToScript(selector)
+	if string(script) != expected {
+		t.Errorf("the compatible script was expected to be '%s', '%s' returned", expected, script)
+		return
+	}
+}
+
+func TestSelectorAdapter_isName_withUnclosedAlternatives_returnsError(t *testing.T) {
+	script := `
+		+ .{five,six
+	`
+
+	adapter := NewAdapter()
+	_, _, err := adapter.ToSelector(script)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestSelectorAdapter_isName_withPattern_Success(t *testing.T) {
+	script := `
+		+ @/^root/ ./^exprBinary(Add|Sub)$/
+	`
+
+	adapter := NewAdapter()
+	selector, _, err := adapter.ToSelector(script)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	name := selector.Name()
+	if !name.HasPattern() {
+		t.Errorf("the name was expected to contain a pattern")
+		return
+	}
+
+	if !name.Pattern().MatchString("exprBinarySub") {
+		t.Errorf("the pattern was expected to match '%s'", "exprBinarySub")
+		return
+	}
+
+	if name.Pattern().MatchString("exprBinaryMul") {
+		t.Errorf("the pattern was NOT expected to match '%s'", "exprBinaryMul")
+		return
+	}
+
+	insides := name.Insides()
+	if !insides[0].HasPattern() {
+		t.Errorf("the inside was expected to contain a pattern")
+		return
+	}
+}
+
+func TestSelectorAdapter_isName_withInvalidPattern_returnsError(t *testing.T) {
+	script := "\n\t\t+ ./(/"
+
+	adapter := NewAdapter()
+	_, _, err := adapter.ToSelector(script)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	if !strings.Contains(err.Error(), "line: 2, column: 6, index: 6") {
+		t.Errorf("the error was expected to contain the pattern's position, '%s' returned", err.Error())
+		return
+	}
+}
+
+func TestSelectorAdapter_isName_withUnclosedPattern_returnsError(t *testing.T) {
+	script := `
+		+ ./five
+	`
+
+	adapter := NewAdapter()
+	_, _, err := adapter.ToSelector(script)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestSelectorAdapter_isName_withNotPredicate_Success(t *testing.T) {
+	script := `
+		+ @rootToken .expr:not(@paren > .expr , .literal)[1]
+	`
+
+	adapter := NewAdapter()
+	selector, _, err := adapter.ToSelector(script)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	name := selector.Name()
+	if !name.HasPredicates() {
+		t.Errorf("the name was expected to contain predicates")
+		return
+	}
+
+	if !name.HasIndex() {
+		t.Errorf("the name was expected to contain an index")
+		return
+	}
+
+	predicates := name.Predicates()
+	if len(predicates) != 1 {
+		t.Errorf("%d predicates were expected, %d returned", 1, len(predicates))
+		return
+	}
+
+	if !predicates[0].IsNot() {
+		t.Errorf("the predicate was expected to be a not predicate")
+		return
+	}
+
+	not := predicates[0].Not()
+	if !not.IsUnion() {
+		t.Errorf("the not predicate was expected to contain a union")
+		return
+	}
+}
+
+func TestSelectorAdapter_isName_withInvalidNotPredicate_returnsError(t *testing.T) {
+	scripts := []string{
+		".expr:not(+ .paren)",
+		".expr:not(.paren *)",
+		".expr:not(.paren[0])",
+		".expr:not(.paren",
+		".expr:not",
+		".expr:is(.paren)",
+	}
+
+	adapter := NewAdapter()
+	for _, oneScript := range scripts {
+		_, _, err := adapter.ToSelector(oneScript)
+		if err == nil {
+			t.Errorf("the error was expected to be valid, nil returned (script: %s)", oneScript)
+			return
+		}
+	}
+}
+
+func TestSelectorAdapter_isName_withValuePredicates_Success(t *testing.T) {
+	script := `
+		+ @pair .key[="a \"host\""][^="a"][*="os"][~=/h.st/]
+	`
+
+	adapter := NewAdapter()
+	selector, _, err := adapter.ToSelector(script)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	name := selector.Name()
+	if name.HasIndex() {
+		t.Errorf("the name was expected to NOT contain an index")
+		return
+	}
+
+	predicates := name.Predicates()
+	if len(predicates) != 4 {
+		t.Errorf("%d predicates were expected, %d returned", 4, len(predicates))
+		return
+	}
+
+	if string(predicates[0].Value().Equal()) != `a "host"` {
+		t.Errorf("the equal value was expected to be '%s', '%s' returned", `a "host"`, predicates[0].Value().Equal())
+		return
+	}
+
+	if string(predicates[1].Value().Prefix()) != "a" {
+		t.Errorf("the prefix value was expected to be '%s', '%s' returned", "a", predicates[1].Value().Prefix())
+		return
+	}
+
+	if string(predicates[2].Value().Contains()) != "os" {
+		t.Errorf("the contains value was expected to be '%s', '%s' returned", "os", predicates[2].Value().Contains())
+		return
+	}
+
+	if !predicates[3].Value().Pattern().MatchString("host") {
+		t.Errorf("the pattern was expected to match '%s'", "host")
+		return
+	}
+}
+
+func TestSelectorAdapter_isName_withInvalidValuePredicate_returnsError(t *testing.T) {
+	scripts := []string{
+		`.key[="host"`,
+		`.key[="host]`,
+		`.key[=host]`,
+		`.key[~="host"]`,
+		`.key[~=/(/]`,
+	}
+
+	adapter := NewAdapter()
+	for _, oneScript := range scripts {
+		_, _, err := adapter.ToSelector(oneScript)
+		if err == nil {
+			t.Errorf("the error was expected to be valid, nil returned (script: %s)", oneScript)
+			return
+		}
+	}
+}
+
+func TestSelectorAdapter_isSibling_Success(t *testing.T) {
+	script := `
+		+ @rootToken .five ~< .smallerThan[0]
+	`
+
+	adapter := NewAdapter()
+	selector, _, err := adapter.ToSelector(script)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !selector.IsSibling() {
+		t.Errorf("the selector was expected to be a sibling")
+		return
+	}
+
+	sibling := selector.Sibling()
+	if !sibling.IsPreceding() {
+		t.Errorf("the sibling was expected to be preceding")
+		return
+	}
+
+	if !sibling.Name().IsSelected() {
+		t.Errorf("the sibling's name was expected to be selected")
+		return
+	}
+
+	target := sibling.Target()
+	if target.Name() != "smallerThan" {
+		t.Errorf("the target was expected to be '%s', '%s' returned", "smallerThan", target.Name())
+		return
+	}
+
+	if !target.HasIndex() {
+		t.Errorf("the target was expected to contain an index")
+		return
+	}
+}
+
+func TestSelectorAdapter_isSibling_withInvalidTarget_returnsError(t *testing.T) {
+	scripts := []string{
+		".five ~ + .smallerThan",
+		".five ~ @rootToken .smallerThan",
+		".five ~",
+		".five ~< *",
+	}
+
+	adapter := NewAdapter()
+	for _, oneScript := range scripts {
+		_, _, err := adapter.ToSelector(oneScript)
+		if err == nil {
+			t.Errorf("the error was expected to be valid, nil returned (script: %s)", oneScript)
+			return
+		}
+	}
+}
+
+func TestSelectorAdapter_isAncestor_Success(t *testing.T) {
+	scripts := map[string]bool{
+		"+ .five ^":             false,
+		"+ .five ^^ .rootToken": true,
+	}
+
+	adapter := NewAdapter()
+	for script, hasTarget := range scripts {
+		selector, _, err := adapter.ToSelector(script)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if !selector.IsAncestor() {
+			t.Errorf("the selector (script: %s) was expected to be an ancestor", script)
+			return
+		}
+
+		if selector.Ancestor().HasTarget() != hasTarget {
+			t.Errorf("the ancestor (script: %s) was expected to have a target: %t", script, hasTarget)
+			return
+		}
+	}
+}
+
+func TestSelectorAdapter_isAncestor_withInvalidTarget_returnsError(t *testing.T) {
+	scripts := []string{
+		".five ^^",
+		".five ^^ + .rootToken",
+		".five ^^ @expr .rootToken",
+		".five ^^ .rootToken[0]",
+	}
+
+	adapter := NewAdapter()
+	for _, oneScript := range scripts {
+		_, _, err := adapter.ToSelector(oneScript)
+		if err == nil {
+			t.Errorf("the error was expected to be valid, nil returned (script: %s)", oneScript)
+			return
+		}
+	}
+}
+
+func TestSelectorAdapter_isBetween_Success(t *testing.T) {
+	scripts := map[string]bool{
+		"+ @rootToken .open * .close":  false,
+		"+ @rootToken .open .. .close": true,
+	}
+
+	adapter := NewAdapter()
+	for script, isInclusive := range scripts {
+		selector, _, err := adapter.ToSelector(script)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if !selector.IsBetween() {
+			t.Errorf("the selector (script: %s) was expected to be a between", script)
+			return
+		}
+
+		between := selector.Between()
+		if between.IsInclusive() != isInclusive {
+			t.Errorf("the between (script: %s) was expected to be inclusive: %t", script, isInclusive)
+			return
+		}
+
+		if between.From().Name() != "open" || between.To().Name() != "close" {
+			t.Errorf("the between (script: %s) was expected to be from '%s' to '%s'", script, "open", "close")
+			return
+		}
+	}
+}
+
+func TestSelectorAdapter_isBetween_withInvalidTo_returnsError(t *testing.T) {
+	scripts := []string{
+		".open ..",
+		".open .. + .close",
+		".open * @expr .close",
+		".open .. .close[0]",
+	}
+
+	adapter := NewAdapter()
+	for _, oneScript := range scripts {
+		selector, remaining, err := adapter.ToSelector(oneScript)
+		if err == nil && len(remaining) <= 0 && selector.IsBetween() {
+			t.Errorf("the error was expected to be valid, nil returned (script: %s)", oneScript)
+			return
+		}
+	}
+}
+
+func TestSelectorAdapter_isAll_Success(t *testing.T) {
+	scripts := map[string]int{
+		"*":                         0,
+		"+ *":                       0,
+		"@rootToken *":              1,
+		"+ @rootToken > @paren *":   2,
+		"\n\t+ @rootToken @paren *": 2,
+	}
+
+	adapter := NewAdapter()
+	for script, amount := range scripts {
+		selector, _, err := adapter.ToSelector(script)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if !selector.IsAll() {
+			t.Errorf("the selector (script: %s) was expected to be an all", script)
+			return
+		}
+
+		all := selector.All()
+		isSelected := strings.HasPrefix(strings.TrimSpace(script), "+")
+		if all.IsSelected() != isSelected {
+			t.Errorf("the all (script: %s) was expected to be selected: %t", script, isSelected)
+			return
+		}
+
+		if len(all.Insides()) != amount {
+			t.Errorf("the all (script: %s) was expected to contain %d insides, %d returned", script, amount, len(all.Insides()))
+			return
+		}
+	}
+}
+
+func TestSelectorAdapter_isName_withAlternative_Success(t *testing.T) {
+	scripts := []string{
+		"+ @rootToken .expr#2",
+		"+ @rootToken .expr:alt(2)",
+	}
+
+	adapter := NewAdapter()
+	for _, oneScript := range scripts {
+		selector, _, err := adapter.ToSelector(oneScript)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		predicates := selector.Name().Predicates()
+		if len(predicates) != 1 || !predicates[0].IsAlternative() {
+			t.Errorf("the name (script: %s) was expected to contain an alternative predicate", oneScript)
+			return
+		}
+
+		if *predicates[0].Alternative() != 2 {
+			t.Errorf("the alternative (script: %s) was expected to be %d, %d returned", oneScript, 2, *predicates[0].Alternative())
+			return
+		}
+	}
+}
+
+func TestSelectorAdapter_isName_withInvalidAlternative_returnsError(t *testing.T) {
+	scripts := []string{
+		".expr#",
+		".expr#-1",
+		".expr#0",
+		".expr:alt(0)",
+		".expr:alt(2",
+		".expr:alt2)",
+		".expr:alt()",
+	}
+
+	adapter := NewAdapter()
+	for _, oneScript := range scripts {
+		_, _, err := adapter.ToSelector(oneScript)
+		if err == nil {
+			t.Errorf("the error was expected to be valid, nil returned (script: %s)", oneScript)
+			return
+		}
+	}
+}
+
+func TestSelectorAdapter_isName_withRepetition_Success(t *testing.T) {
+	adapter := NewAdapter()
+	selector, _, err := adapter.ToSelector("+ .digit{1}")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	name := selector.Name()
+	if name.Name() != "digit" {
+		t.Errorf("the name was expected to be '%s', '%s' returned", "digit", name.Name())
+		return
+	}
+
+	if !name.HasRepetition() || !name.Repetition().IsIndex() {
+		t.Errorf("the name was expected to contain a repetition index")
+		return
+	}
+
+	if *name.Repetition().Index().Position() != 1 {
+		t.Errorf("the repetition was expected to select the position %d, %d returned", 1, *name.Repetition().Index().Position())
+		return
+	}
+
+	selector, _, err = adapter.ToSelector("+ .{digit,number}")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if selector.Name().HasRepetition() {
+		t.Errorf("the alternatives were NOT expected to be parsed as a repetition")
+		return
+	}
+}
+
+func TestSelectorAdapter_isName_withInvalidRepetition_returnsError(t *testing.T) {
+	scripts := []string{
+		".digit{-}",
+		".digit{1}{2}",
+		".digit{0",
+	}
+
+	adapter := NewAdapter()
+	for _, oneScript := range scripts {
+		_, remaining, err := adapter.ToSelector(oneScript)
+		if err == nil && len(remaining) <= 0 {
+			t.Errorf("the error was expected to be valid, nil returned (script: %s)", oneScript)
+			return
+		}
+	}
+}
+
+func TestSelectorAdapter_isChannel_Success(t *testing.T) {
+	script := `
+		+ @declarations .function ::channel( .comment[0] )
+	`
+
+	adapter := NewAdapter()
+	selector, _, err := adapter.ToSelector(script)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !selector.IsChannel() {
+		t.Errorf("the selector was expected to be a channel")
+		return
+	}
+
+	channel := selector.Channel()
+	if !channel.Name().IsSelected() {
+		t.Errorf("the channel's name was expected to be selected")
+		return
+	}
+
+	if channel.Name().Name() != "function" {
+		t.Errorf("the name was expected to be '%s', '%s' returned", "function", channel.Name().Name())
+		return
+	}
+
+	target := channel.Target()
+	if target.Name() != "comment" {
+		t.Errorf("the target was expected to be '%s', '%s' returned", "comment", target.Name())
+		return
+	}
+
+	if !target.HasIndex() {
+		t.Errorf("the target was expected to contain an index")
+		return
+	}
+}
+
+func TestSelectorAdapter_isChannel_withInvalidAxis_returnsError(t *testing.T) {
+	scripts := []string{
+		".function ::comment(.comment)",
+		".function ::channel .comment",
+		".function ::channel(.comment",
+		".function ::channel(+ .comment)",
+		".function ::channel(@line .comment)",
+	}
+
+	adapter := NewAdapter()
+	for _, oneScript := range scripts {
+		_, _, err := adapter.ToSelector(oneScript)
+		if err == nil {
+			t.Errorf("the error was expected to be valid, nil returned (script: %s)", oneScript)
+			return
+		}
+	}
+}
+
+func TestSelectorAdapter_withParseError_returnsError(t *testing.T) {
+	cases := []struct {
+		script   string
+		line     uint
+		column   uint
+		offset   uint
+		expected string
+		found    string
+		caret    string
+	}{
+		{
+			script:   "\n\t\t+ .five ~",
+			line:     2,
+			column:   12,
+			offset:   12,
+			expected: "a token name",
+			found:    "the end of the script",
+			caret:    "\t\t+ .five ~\n\t\t         ^",
+		},
+		{
+			script:   "+ .five:maybe(.x)\n",
+			line:     1,
+			column:   9,
+			offset:   8,
+			expected: "not or alt",
+			found:    "maybe(.x)",
+			caret:    "+ .five:maybe(.x)\n        ^",
+		},
+		{
+			script:   "+ @list\n  .item[ 2 : 5 .other",
+			line:     2,
+			column:   16,
+			offset:   23,
+			expected: "]",
+			found:    ".other",
+			caret:    "  .item[ 2 : 5 .other\n               ^",
+		},
+		{
+			script:   "+ .a:not(.b *)",
+			line:     1,
+			column:   10,
+			offset:   9,
+			expected: "a selector of unselected names",
+			found:    ".b",
+			caret:    "+ .a:not(.b *)\n         ^",
+		},
+		{
+			script:   "+ .a[]",
+			line:     1,
+			column:   6,
+			offset:   5,
+			expected: "a position or a slice",
+			found:    "]",
+			caret:    "+ .a[]\n     ^",
+		},
+		{
+			script:   "+ > .b",
+			line:     1,
+			column:   3,
+			offset:   2,
+			expected: "an inside name",
+			found:    ">",
+			caret:    "+ > .b\n  ^",
+		},
+		{
+			script:   `+ .a[="b]`,
+			line:     1,
+			column:   7,
+			offset:   6,
+			expected: "a valid string",
+			found:    `"b]`,
+			caret:    "+ .a[=\"b]\n      ^",
+		},
+	}
+
+	adapter := NewAdapter()
+	for _, oneCase := range cases {
+		_, _, err := adapter.ToSelector(oneCase.script)
+		if err == nil {
+			t.Errorf("the error was expected to be valid, nil returned (script: %q)", oneCase.script)
+			return
+		}
+
+		var parseErr ParseError
+		if !errors.As(err, &parseErr) {
+			t.Errorf("the error was expected to be a ParseError (script: %q)", oneCase.script)
+			return
+		}
+
+		if parseErr.Line() != oneCase.line || parseErr.Column() != oneCase.column || parseErr.Offset() != oneCase.offset {
+			t.Errorf("the error was expected at (line: %d, column: %d, offset: %d), (line: %d, column: %d, offset: %d) returned (script: %q)", oneCase.line, oneCase.column, oneCase.offset, parseErr.Line(), parseErr.Column(), parseErr.Offset(), oneCase.script)
+			return
+		}
+
+		if parseErr.Expected() != oneCase.expected {
+			t.Errorf("the error was expected to expect '%s', '%s' returned (script: %q)", oneCase.expected, parseErr.Expected(), oneCase.script)
+			return
+		}
+
+		if parseErr.Found() != oneCase.found {
+			t.Errorf("the error was expected to find '%s', '%s' returned (script: %q)", oneCase.found, parseErr.Found(), oneCase.script)
+			return
+		}
+
+		if parseErr.Caret() != oneCase.caret {
+			t.Errorf("the caret was expected to be %q, %q returned (script: %q)", oneCase.caret, parseErr.Caret(), oneCase.script)
+			return
+		}
+	}
+}
+
+func TestSelectorAdapter_withChannelCharactersInsideLexeme_returnsError(t *testing.T) {
+	scripts := []string{
+		"+ . five",
+		". my Token",
+		"@ rootToken .five",
+		"+ .five ~ < .smallerThan",
+		"+ .five : : channel(.comment)",
+		"+ .five[- 1]",
+		"+ .five# 1",
+		`+ .key[~ = "host"]`,
+	}
+
+	adapter := NewAdapter()
+	for _, oneScript := range scripts {
+		_, _, err := adapter.ToSelector(oneScript)
+		if err == nil {
+			t.Errorf("the error was expected to be valid, nil returned (script: %s)", oneScript)
+			return
+		}
+	}
+}
+
+func TestSelectorAdapter_withChannelCharactersBetweenLexemes_Success(t *testing.T) {
+	scripts := map[string]string{
+		".my Token":                      ".my",
+		"+ .number { 1 : }":              "+ .number{1:}",
+		"+ @list .item [ -1 ] *":         "+ @list .item[-1] *",
+		"+ .five ~<\n\t.smallerThan":     "+ .five ~< .smallerThan",
+		`+ .key [ ^= "ho" ] : not( .x )`: `+ .key[^="ho"]:not(.x)`,
+	}
+
+	adapter := NewAdapter()
+	for script, expected := range scripts {
+		selector, _, err := adapter.ToSelector(script)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retScript := adapter.ToScript(selector)
+		if string(retScript) != expected {
+			t.Errorf("the script was expected to be '%s', '%s' returned", expected, retScript)
+			return
+		}
+	}
+}
+
+func TestSelectorAdapter_withCompatibility_Success(t *testing.T) {
+	scripts := map[string]string{
+		". my Token":        ".myToken",
+		"+ . five":          "+ .five",
+		"@ rootToken .five": "@rootToken .five",
+		"+ .five[- 1]":      "+ .five[-1]",
+		"+@a.b*":            "+ @a .b *",
+		"+@a.b*,+.c":        "+ @a .b * , + .c",
+	}
+
+	adapter := NewCompatibilityAdapter()
+	for script, expected := range scripts {
+		selector, _, err := adapter.ToSelector(script)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retScript := adapter.ToScript(selector)
+		if string(retScript) != expected {
+			t.Errorf("the script was expected to be '%s', '%s' returned", expected, retScript)
+			return
+		}
+	}
+}
+
+func TestSelectorAdapter_toStrictSelector_Success(t *testing.T) {
+	script := `
+		+ @rootToken .five , .smallerThan
+	`
+
+	adapter := NewAdapter()
+	selector, err := adapter.ToStrictSelector(script)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !selector.IsUnion() {
+		t.Errorf("the selector was expected to be a union")
+		return
+	}
+}
+
+func TestSelectorAdapter_toStrictSelector_withTrailingInput_returnsError(t *testing.T) {
+	cases := map[string]uint{
+		"+ .five )":    8,
+		"+ .my Token":  6,
+		"+ .five\n\t]": 9,
+	}
+
+	adapter := NewAdapter()
+	for script, offset := range cases {
+		_, err := adapter.ToStrictSelector(script)
+		if err == nil {
+			t.Errorf("the error was expected to be valid, nil returned (script: %q)", script)
+			return
+		}
+
+		var parseErr ParseError
+		if !errors.As(err, &parseErr) {
+			t.Errorf("the error was expected to be a ParseError (script: %q)", script)
+			return
+		}
+
+		if parseErr.Offset() != offset {
+			t.Errorf("the error was expected at the offset %d, %d returned (script: %q)", offset, parseErr.Offset(), script)
+			return
+		}
+	}
+}
+
+func TestSelectorAdapter_toStrictStatements_withTrailingInput_returnsError(t *testing.T) {
+	cases := map[string]uint{
+		"first = + .five;\nsecond = + .six":    32,
+		"first = + .five;\nsecond = + .six; )": 34,
+	}
+
+	adapter := NewAdapter()
+	for script, offset := range cases {
+		_, err := adapter.ToStrictStatements(script)
+		if err == nil {
+			t.Errorf("the error was expected to be valid, nil returned (script: %q)", script)
+			return
+		}
+
+		var parseErr ParseError
+		if !errors.As(err, &parseErr) {
+			t.Errorf("the error was expected to be a ParseError (script: %q)", script)
+			return
+		}
+
+		if parseErr.Offset() != offset {
+			t.Errorf("the error was expected at the offset %d, %d returned (script: %q)", offset, parseErr.Offset(), script)
+			return
+		}
+	}
+}
+
+func TestSelectorAdapter_withComments_Success(t *testing.T) {
+	script := `
+		// the root of the selection:
+		+ @rootToken /* the inside */ .five[~=/^\/\//] , .smallerThan // the union
+	`
+
+	adapter := NewAdapter()
+	selector, err := adapter.ToStrictSelector(script)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := "+ @rootToken .five[~=/^\\/\\//] , .smallerThan"
+	if string(adapter.ToScript(selector)) != expected {
+		t.Errorf("the script was expected to be %q, %q returned", expected, adapter.ToScript(selector))
+		return
+	}
+}
+
+func TestSelectorAdapter_withUnclosedComment_returnsError(t *testing.T) {
+	script := "+ @rootToken /* the inside .five"
+	_, _, err := NewAdapter().ToSelector(script)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	var parseErr ParseError
+	if !errors.As(err, &parseErr) {
+		t.Errorf("the error was expected to be a ParseError")
+		return
+	}
+
+	if parseErr.Expected() != "*/" {
+		t.Errorf("the expected element was expected to be %q, %q returned", "*/", parseErr.Expected())
+		return
+	}
+}
+
+func TestSelectorAdapter_format_Success(t *testing.T) {
+	adapter := NewAdapter()
+	selector, err := adapter.FormatSelector("+@rootToken   /* the inside */ .five // the selector\n")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := "/* the inside */\n+ @rootToken .five // the selector"
+	if string(selector) != expected {
+		t.Errorf("the formatted selector was expected to be %q, %q returned", expected, selector)
+		return
+	}
+
+	// the comments are kept at the line level, so a comment inside a union is written before it:
+	union, err := adapter.FormatSelector("+ .x /* a */ , + .y // b")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected = "/* a */\n+ .x , + .y // b"
+	if string(union) != expected {
+		t.Errorf("the formatted union was expected to be %q, %q returned", expected, union)
+		return
+	}
+
+	script := `// the statements:
+		first = +.five; // the first
+		second = /* inside */ .six ;
+		/* the end */`
+
+	statements, err := adapter.FormatStatements(script)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected = "// the statements:\nfirst = + .five; // the first\n/* inside */\nsecond = .six;\n/* the end */"
+	if string(statements) != expected {
+		t.Errorf("the formatted statements were expected to be %q, %q returned", expected, statements)
+		return
+	}
+
+	_, err = adapter.FormatStatements("first = + .five; )")
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestSelectorAdapter_withTokenNames_Success(t *testing.T) {
+	scripts := map[string]string{
+		"+ @ipv4_part .h2":    "ipv4_part",
+		"+ @_part .h2":        "_part",
+		"+ @\"any name\" .h2": "any name",
+		"+ @\"a\\\"b\" .h2":   "a\"b",
+	}
+
+	adapter := NewAdapter()
+	for script, expected := range scripts {
+		selector, err := adapter.ToStrictSelector(script)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s (script: %q)", err.Error(), script)
+			return
+		}
+
+		name := selector.Name()
+		if name.Name() != "h2" {
+			t.Errorf("the name was expected to be %q, %q returned (script: %q)", "h2", name.Name(), script)
+			return
+		}
+
+		insides := name.Insides()
+		if insides[0].Name() != expected {
+			t.Errorf("the inside name was expected to be %q, %q returned (script: %q)", expected, insides[0].Name(), script)
+			return
+		}
+	}
+
+	selector, err := adapter.ToStrictSelector(`+ ."a*b"`)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	literal := selector.Name()
+	if !literal.IsLiteral() || literal.HasPattern() || literal.Name() != "a*b" {
+		t.Errorf("the quoted name was expected to be matched literally")
+		return
+	}
+
+	selector, err = adapter.ToStrictSelector(`+ @"a*b" .h2`)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	inside := selector.Name().Insides()[0]
+	if !inside.IsLiteral() || inside.HasPattern() || inside.Name() != "a*b" {
+		t.Errorf("the quoted inside name was expected to be matched literally")
+		return
+	}
+
+	invalids := []string{
+		"+ .2h",
+		"+ .\"\"",
+		"+ .\"name",
+	}
+
+	for _, oneScript := range invalids {
+		_, _, err := adapter.ToSelector(oneScript)
+		if err == nil {
+			t.Errorf("the error was expected to be valid, nil returned (script: %q)", oneScript)
+			return
+		}
+	}
+}
+
+func TestSelectorAdapter_withTokenNameCharacters_Success(t *testing.T) {
+	adapter := NewAdapterWithTokenNameCharacters([]byte("abc"), []byte("-"))
+	selector, err := adapter.ToStrictSelector("+ .ab-c")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if selector.Name().Name() != "ab-c" {
+		t.Errorf("the name was expected to be %q, %q returned", "ab-c", selector.Name().Name())
+		return
+	}
+
+	_, err = adapter.ToStrictSelector("+ .-ab")
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestSelectorAdapter_toScript_roundTrip_Success(t *testing.T) {
+	scripts := map[string]string{
+		".myToken":                              ".myToken",
+		"+ .myToken":                            "+ .myToken",
+		"+ @firstInside @secondInside .myToken": "+ @firstInside @secondInside .myToken",
+		"+ @firstInside @secondInside .myToken *":     "+ @firstInside @secondInside .myToken *",
+		"@firstInside @secondInside .myToken *":       "@firstInside @secondInside .myToken *",
+		"\n\t+@firstInside   @secondInside.myToken *": "+ @firstInside @secondInside .myToken *",
+		".five[1]":                       ".five[1]",
+		"+ .{num*}":                      "+ .{num*}",
+		"+ .{num*} *":                    "+ .{num*} *",
+		"+ .num*":                        "+ .num*",
+		"+ .num* *":                      "+ .num* *",
+		"*":                              "*",
+		"+ *":                            "+ *",
+		"@rootToken *":                   "@rootToken *",
+		"+ @rootToken > @paren *":        "+ @rootToken > @paren *",
+		"+ @ipv4_part .h2":               "+ @ipv4_part .h2",
+		`+ ."any name"`:                  `+ ."any name"`,
+		`+ ."a\"b"`:                      `+ ."a\"b"`,
+		`+ ."a*b"`:                       `+ ."a*b"`,
+		`+ @"a{b,c}" .h2`:                `+ @"a{b,c}" .h2`,
+		`+ ."five"`:                      `+ .five`,
+		"+ .five[-1]":                    "+ .five[-1]",
+		"+ @list .item[2:5]":             "+ @list .item[2:5]",
+		"+ @list .item[ 2 : ]":           "+ @list .item[2:]",
+		"+ @list .item[:-1]":             "+ @list .item[:-1]",
+		"+ @list .item[:]":               "+ @list .item[:]",
+		"+ @list .item[0] *":             "+ @list .item[0] *",
+		"+ @rootToken .*:not(.five)":     "+ @rootToken .*:not(.five)",
+		"+.expr:not(@paren>.expr,.x)[0]": "+ .expr:not(@paren > .expr , .x)[0]",
+		"+ .expr[0]:not( .a ):not( .b:not( @c .b ) ) *": "+ .expr:not(.a):not(.b:not(@c .b))[0] *",
+		`+ @pair .key[ = "host" ]`:                      `+ @pair .key[="host"]`,
+		`+ .key[^="ho"][*="o s"][0]`:                    `+ .key[^="ho"][*="o s"][0]`,
+		`.key[="a\"b\\c"]`:                              `.key[="a\"b\\c"]`,
+		`+ .key[~=/h.st/]:not(.key[="ghost"]) *`:        `+ .key[~=/h.st/]:not(.key[="ghost"]) *`,
+		"+ .function::channel(.comment)":                "+ .function ::channel(.comment)",
+		"+ @body .*:not(.x) ::channel( .comment[-1] )":  "+ @body .*:not(.x) ::channel(.comment[-1])",
+		"+ .a , + .b":                                   "+ .a , + .b",
+		"+ .a,.b *":                                     "+ .a , .b *",
+		"+ .a ~ .b":                                     "+ .a ~ .b",
+		"+ .a ~< .b[0]":                                 "+ .a ~< .b[0]",
+		"+ .a ^":                                        "+ .a ^",
+		"+ .a ^^ .b":                                    "+ .a ^^ .b",
+		"+ .a * .b":                                     "+ .a * .b",
+		"+ .a .. .b":                                    "+ .a .. .b",
+		"+ .expr#2":                                     "+ .expr#2",
+		"+ .expr:alt(2)":                                "+ .expr#2",
+		"+ .a{0}":                                       "+ .a{0}",
+		"+ .a{1:3}":                                     "+ .a{1:3}",
+		"+ .a{#}":                                       "+ .a{#}",
+		"+ .a{*}":                                       "+ .a{*}",
+		"+ ./^num.*$/":                                  "+ ./^num.*$/",
+		"+ @/^root/ .five":                              "+ @/^root/ .five",
+		"+ @a > .b":                                     "+ @a > .b",
+		"+ @a > @b .c":                                  "+ @a > @b .c",
+	}
+
+	adapter := NewAdapter()
+	for script, expected := range scripts {
+		selector, _, err := adapter.ToSelector(script)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retScript := adapter.ToScript(selector)
+		if string(retScript) != expected {
+			t.Errorf("the script was expected to be '%s', '%s' returned", expected, retScript)
+			return
+		}
+
+		retSelector, _, err := adapter.ToSelector(string(retScript))
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if !reflect.DeepEqual(selector, retSelector) {
+			t.Errorf("the selector (script: '%s') was expected to be the same after the round trip", expected)
+			return
+		}
+	}
+}
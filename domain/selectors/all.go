@@ -0,0 +1,46 @@
+package selectors
+
+type all struct {
+	isSelected bool
+	insides    []Inside
+}
+
+func createAll(
+	isSelected bool,
+) All {
+	return createAllInternally(isSelected, nil)
+}
+
+func createAllWithInsides(
+	isSelected bool,
+	insides []Inside,
+) All {
+	return createAllInternally(isSelected, insides)
+}
+
+func createAllInternally(
+	isSelected bool,
+	insides []Inside,
+) All {
+	out := all{
+		isSelected: isSelected,
+		insides:    insides,
+	}
+
+	return &out
+}
+
+// IsSelected returns true if the all is written with its selection prefix, false otherwise
+func (obj *all) IsSelected() bool {
+	return obj.isSelected
+}
+
+// HasInsides returns true if there is insides, false otherwise
+func (obj *all) HasInsides() bool {
+	return obj.insides != nil
+}
+
+// Insides returns the insides, if any
+func (obj *all) Insides() []Inside {
+	return obj.insides
+}
@@ -0,0 +1,51 @@
+package selectors
+
+import "errors"
+
+type allBuilder struct {
+	isSelected bool
+	insides    []Inside
+}
+
+func createAllBuilder() AllBuilder {
+	out := allBuilder{
+		isSelected: false,
+		insides:    nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *allBuilder) Create() AllBuilder {
+	return createAllBuilder()
+}
+
+// IsSelected flags the builder as selected
+func (app *allBuilder) IsSelected() AllBuilder {
+	app.isSelected = true
+	return app
+}
+
+// WithInsides add insides to the builder
+func (app *allBuilder) WithInsides(insides []Inside) AllBuilder {
+	app.insides = insides
+	return app
+}
+
+// Now builds a new All instance
+func (app *allBuilder) Now() (All, error) {
+	if app.insides != nil && len(app.insides) <= 0 {
+		app.insides = nil
+	}
+
+	if app.insides != nil {
+		if app.insides[0].IsChild() {
+			return nil, errors.New("the first inside of an All cannot be a direct child")
+		}
+
+		return createAllWithInsides(app.isSelected, app.insides), nil
+	}
+
+	return createAll(app.isSelected), nil
+}
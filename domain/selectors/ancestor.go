@@ -0,0 +1,46 @@
+package selectors
+
+type ancestor struct {
+	name   Name
+	target Name
+}
+
+func createAncestor(
+	name Name,
+) Ancestor {
+	return createAncestorInternally(name, nil)
+}
+
+func createAncestorWithTarget(
+	name Name,
+	target Name,
+) Ancestor {
+	return createAncestorInternally(name, target)
+}
+
+func createAncestorInternally(
+	name Name,
+	target Name,
+) Ancestor {
+	out := ancestor{
+		name:   name,
+		target: target,
+	}
+
+	return &out
+}
+
+// Name returns the name of the token whose ancestor is selected
+func (obj *ancestor) Name() Name {
+	return obj.name
+}
+
+// HasTarget returns true if there is a target, false otherwise
+func (obj *ancestor) HasTarget() bool {
+	return obj.target != nil
+}
+
+// Target returns the name of the selected ancestor, if any
+func (obj *ancestor) Target() Name {
+	return obj.target
+}
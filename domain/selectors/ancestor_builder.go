@@ -0,0 +1,59 @@
+package selectors
+
+import "errors"
+
+type ancestorBuilder struct {
+	name   Name
+	target Name
+}
+
+func createAncestorBuilder() AncestorBuilder {
+	out := ancestorBuilder{
+		name:   nil,
+		target: nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *ancestorBuilder) Create() AncestorBuilder {
+	return createAncestorBuilder()
+}
+
+// WithName adds a name to the builder
+func (app *ancestorBuilder) WithName(name Name) AncestorBuilder {
+	app.name = name
+	return app
+}
+
+// WithTarget adds a target to the builder
+func (app *ancestorBuilder) WithTarget(target Name) AncestorBuilder {
+	app.target = target
+	return app
+}
+
+// Now builds a new Ancestor instance
+func (app *ancestorBuilder) Now() (Ancestor, error) {
+	if app.name == nil {
+		return nil, errors.New("the name is mandatory in order to build an Ancestor instance")
+	}
+
+	if app.target != nil {
+		if app.target.IsSelected() {
+			return nil, errors.New("the target of an Ancestor cannot be selected, the selection belongs to its name")
+		}
+
+		if app.target.HasInsides() {
+			return nil, errors.New("the target of an Ancestor cannot contain insides")
+		}
+
+		if app.target.HasIndex() {
+			return nil, errors.New("the target of an Ancestor cannot contain an index")
+		}
+
+		return createAncestorWithTarget(app.name, app.target), nil
+	}
+
+	return createAncestor(app.name), nil
+}
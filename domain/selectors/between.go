@@ -0,0 +1,36 @@
+package selectors
+
+type between struct {
+	from        Name
+	to          Name
+	isInclusive bool
+}
+
+func createBetween(
+	from Name,
+	to Name,
+	isInclusive bool,
+) Between {
+	out := between{
+		from:        from,
+		to:          to,
+		isInclusive: isInclusive,
+	}
+
+	return &out
+}
+
+// From returns the name of the token the range starts from
+func (obj *between) From() Name {
+	return obj.from
+}
+
+// To returns the name of the token the range ends at
+func (obj *between) To() Name {
+	return obj.to
+}
+
+// IsInclusive returns true if the range includes its boundary tokens, false otherwise
+func (obj *between) IsInclusive() bool {
+	return obj.isInclusive
+}
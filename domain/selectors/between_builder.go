@@ -0,0 +1,67 @@
+package selectors
+
+import "errors"
+
+type betweenBuilder struct {
+	from        Name
+	to          Name
+	isInclusive bool
+}
+
+func createBetweenBuilder() BetweenBuilder {
+	out := betweenBuilder{
+		from:        nil,
+		to:          nil,
+		isInclusive: false,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *betweenBuilder) Create() BetweenBuilder {
+	return createBetweenBuilder()
+}
+
+// WithFrom adds a from name to the builder
+func (app *betweenBuilder) WithFrom(from Name) BetweenBuilder {
+	app.from = from
+	return app
+}
+
+// WithTo adds a to name to the builder
+func (app *betweenBuilder) WithTo(to Name) BetweenBuilder {
+	app.to = to
+	return app
+}
+
+// IsInclusive flags the builder as inclusive
+func (app *betweenBuilder) IsInclusive() BetweenBuilder {
+	app.isInclusive = true
+	return app
+}
+
+// Now builds a new Between instance
+func (app *betweenBuilder) Now() (Between, error) {
+	if app.from == nil {
+		return nil, errors.New("the from name is mandatory in order to build a Between instance")
+	}
+
+	if app.to == nil {
+		return nil, errors.New("the to name is mandatory in order to build a Between instance")
+	}
+
+	if app.to.IsSelected() {
+		return nil, errors.New("the to name of a Between cannot be selected, the selection belongs to its from name")
+	}
+
+	if app.to.HasInsides() {
+		return nil, errors.New("the to name of a Between cannot contain insides")
+	}
+
+	if app.to.HasIndex() {
+		return nil, errors.New("the to name of a Between cannot contain an index")
+	}
+
+	return createBetween(app.from, app.to, app.isInclusive), nil
+}
@@ -1,16 +1,31 @@
 package selectors
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 type builder struct {
-	name Name
-	any  Name
+	name     Name
+	any      Name
+	union    []Selector
+	sibling  Sibling
+	ancestor Ancestor
+	between  Between
+	all      All
+	channel  Channel
 }
 
 func createBuilder() Builder {
 	out := builder{
-		name: nil,
-		any:  nil,
+		name:     nil,
+		any:      nil,
+		union:    nil,
+		sibling:  nil,
+		ancestor: nil,
+		between:  nil,
+		all:      nil,
+		channel:  nil,
 	}
 
 	return &out
@@ -33,6 +48,42 @@ func (app *builder) WithAny(any Name) Builder {
 	return app
 }
 
+// WithUnion adds a union to the builder
+func (app *builder) WithUnion(union []Selector) Builder {
+	app.union = union
+	return app
+}
+
+// WithSibling adds a sibling to the builder
+func (app *builder) WithSibling(sibling Sibling) Builder {
+	app.sibling = sibling
+	return app
+}
+
+// WithAncestor adds an ancestor to the builder
+func (app *builder) WithAncestor(ancestor Ancestor) Builder {
+	app.ancestor = ancestor
+	return app
+}
+
+// WithBetween adds a between to the builder
+func (app *builder) WithBetween(between Between) Builder {
+	app.between = between
+	return app
+}
+
+// WithAll adds an all to the builder
+func (app *builder) WithAll(all All) Builder {
+	app.all = all
+	return app
+}
+
+// WithChannel adds a channel to the builder
+func (app *builder) WithChannel(channel Channel) Builder {
+	app.channel = channel
+	return app
+}
+
 // Now builds a new Selector instance
 func (app *builder) Now() (Selector, error) {
 	if app.name != nil {
@@ -43,5 +94,34 @@ func (app *builder) Now() (Selector, error) {
 		return createSelectorWithAnySelector(app.any), nil
 	}
 
+	if app.union != nil {
+		if len(app.union) < 2 {
+			str := fmt.Sprintf("the union must contain at least %d Selector instances, %d provided", 2, len(app.union))
+			return nil, errors.New(str)
+		}
+
+		return createSelectorWithUnion(app.union), nil
+	}
+
+	if app.sibling != nil {
+		return createSelectorWithSibling(app.sibling), nil
+	}
+
+	if app.ancestor != nil {
+		return createSelectorWithAncestor(app.ancestor), nil
+	}
+
+	if app.between != nil {
+		return createSelectorWithBetween(app.between), nil
+	}
+
+	if app.all != nil {
+		return createSelectorWithAll(app.all), nil
+	}
+
+	if app.channel != nil {
+		return createSelectorWithChannel(app.channel), nil
+	}
+
 	return nil, errors.New("the Selector is invalid")
 }
@@ -0,0 +1,28 @@
+package selectors
+
+type channel struct {
+	name   Name
+	target Name
+}
+
+func createChannel(
+	name Name,
+	target Name,
+) Channel {
+	out := channel{
+		name:   name,
+		target: target,
+	}
+
+	return &out
+}
+
+// Name returns the name of the token whose channels are selected
+func (obj *channel) Name() Name {
+	return obj.name
+}
+
+// Target returns the name of the selected channel tokens
+func (obj *channel) Target() Name {
+	return obj.target
+}
@@ -0,0 +1,59 @@
+package selectors
+
+import "errors"
+
+type channelBuilder struct {
+	name   Name
+	target Name
+}
+
+func createChannelBuilder() ChannelBuilder {
+	out := channelBuilder{
+		name:   nil,
+		target: nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *channelBuilder) Create() ChannelBuilder {
+	return createChannelBuilder()
+}
+
+// WithName adds a name to the builder
+func (app *channelBuilder) WithName(name Name) ChannelBuilder {
+	app.name = name
+	return app
+}
+
+// WithTarget adds a target to the builder
+func (app *channelBuilder) WithTarget(target Name) ChannelBuilder {
+	app.target = target
+	return app
+}
+
+// Now builds a new Channel instance
+func (app *channelBuilder) Now() (Channel, error) {
+	if app.name == nil {
+		return nil, errors.New("the name is mandatory in order to build a Channel instance")
+	}
+
+	if app.target == nil {
+		return nil, errors.New("the target is mandatory in order to build a Channel instance")
+	}
+
+	if app.target.IsSelected() {
+		return nil, errors.New("the target of a Channel cannot be selected, the selection belongs to its name")
+	}
+
+	if app.target.HasInsides() {
+		return nil, errors.New("the target of a Channel cannot contain insides")
+	}
+
+	if app.target.HasRepetition() {
+		return nil, errors.New("the target of a Channel cannot contain a repetition")
+	}
+
+	return createChannel(app.name, app.target), nil
+}
@@ -0,0 +1,50 @@
+package selectors
+
+type index struct {
+	pPosition *int
+	slice     Slice
+}
+
+func createIndexWithPosition(
+	pPosition *int,
+) Index {
+	return createIndexInternally(pPosition, nil)
+}
+
+func createIndexWithSlice(
+	slice Slice,
+) Index {
+	return createIndexInternally(nil, slice)
+}
+
+func createIndexInternally(
+	pPosition *int,
+	slice Slice,
+) Index {
+	out := index{
+		pPosition: pPosition,
+		slice:     slice,
+	}
+
+	return &out
+}
+
+// IsPosition returns true if there is a position, false otherwise
+func (obj *index) IsPosition() bool {
+	return obj.pPosition != nil
+}
+
+// Position returns the position, if any
+func (obj *index) Position() *int {
+	return obj.pPosition
+}
+
+// IsSlice returns true if there is a slice, false otherwise
+func (obj *index) IsSlice() bool {
+	return obj.slice != nil
+}
+
+// Slice returns the slice, if any
+func (obj *index) Slice() Slice {
+	return obj.slice
+}
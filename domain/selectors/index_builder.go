@@ -0,0 +1,47 @@
+package selectors
+
+import "errors"
+
+type indexBuilder struct {
+	pPosition *int
+	slice     Slice
+}
+
+func createIndexBuilder() IndexBuilder {
+	out := indexBuilder{
+		pPosition: nil,
+		slice:     nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *indexBuilder) Create() IndexBuilder {
+	return createIndexBuilder()
+}
+
+// WithPosition adds a position to the builder
+func (app *indexBuilder) WithPosition(position int) IndexBuilder {
+	app.pPosition = &position
+	return app
+}
+
+// WithSlice adds a slice to the builder
+func (app *indexBuilder) WithSlice(slice Slice) IndexBuilder {
+	app.slice = slice
+	return app
+}
+
+// Now builds a new Index instance
+func (app *indexBuilder) Now() (Index, error) {
+	if app.pPosition != nil {
+		return createIndexWithPosition(app.pPosition), nil
+	}
+
+	if app.slice != nil {
+		return createIndexWithSlice(app.slice), nil
+	}
+
+	return nil, errors.New("the Index is invalid")
+}
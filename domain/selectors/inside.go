@@ -0,0 +1,51 @@
+package selectors
+
+import "regexp"
+
+type inside struct {
+	isChild   bool
+	isLiteral bool
+	name      string
+	pattern   *regexp.Regexp
+}
+
+func createInside(
+	isChild bool,
+	isLiteral bool,
+	name string,
+	pattern *regexp.Regexp,
+) Inside {
+	out := inside{
+		isChild:   isChild,
+		isLiteral: isLiteral,
+		name:      name,
+		pattern:   pattern,
+	}
+
+	return &out
+}
+
+// IsChild returns true if the inside must be a direct child of the previous inside, false otherwise
+func (obj *inside) IsChild() bool {
+	return obj.isChild
+}
+
+// IsLiteral returns true if the name is matched literally, without its wildcards and alternatives, false otherwise
+func (obj *inside) IsLiteral() bool {
+	return obj.isLiteral
+}
+
+// Name returns the name
+func (obj *inside) Name() string {
+	return obj.name
+}
+
+// HasPattern returns true if there is a pattern, false otherwise
+func (obj *inside) HasPattern() bool {
+	return obj.pattern != nil
+}
+
+// Pattern returns the pattern, if any
+func (obj *inside) Pattern() *regexp.Regexp {
+	return obj.pattern
+}
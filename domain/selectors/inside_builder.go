@@ -0,0 +1,70 @@
+package selectors
+
+import (
+	"errors"
+	"regexp"
+)
+
+type insideBuilder struct {
+	isChild   bool
+	isLiteral bool
+	name      string
+	pattern   *regexp.Regexp
+}
+
+func createInsideBuilder() InsideBuilder {
+	out := insideBuilder{
+		isChild:   false,
+		isLiteral: false,
+		name:      "",
+		pattern:   nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *insideBuilder) Create() InsideBuilder {
+	return createInsideBuilder()
+}
+
+// IsChild flags the builder as a direct child
+func (app *insideBuilder) IsChild() InsideBuilder {
+	app.isChild = true
+	return app
+}
+
+// IsLiteral flags the builder as a literal name, whose wildcards and alternatives are not special
+func (app *insideBuilder) IsLiteral() InsideBuilder {
+	app.isLiteral = true
+	return app
+}
+
+// WithName adds a name to the builder
+func (app *insideBuilder) WithName(name string) InsideBuilder {
+	app.name = name
+	return app
+}
+
+// WithPattern adds a pattern to the builder
+func (app *insideBuilder) WithPattern(pattern *regexp.Regexp) InsideBuilder {
+	app.pattern = pattern
+	return app
+}
+
+// Now builds a new Inside instance
+func (app *insideBuilder) Now() (Inside, error) {
+	if app.name == "" && app.pattern == nil {
+		return nil, errors.New("the name or pattern is mandatory in order to build an Inside instance")
+	}
+
+	if app.name != "" && app.pattern != nil {
+		return nil, errors.New("the name and pattern cannot be both provided in order to build an Inside instance")
+	}
+
+	if app.isLiteral && app.pattern != nil {
+		return nil, errors.New("the pattern cannot be literal in order to build an Inside instance")
+	}
+
+	return createInside(app.isChild, app.isLiteral, app.name, app.pattern), nil
+}
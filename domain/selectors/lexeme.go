@@ -0,0 +1,23 @@
+package selectors
+
+type lexeme struct {
+	data   []byte
+	offset int
+}
+
+func createLexeme(
+	data []byte,
+	offset int,
+) *lexeme {
+	out := lexeme{
+		data:   data,
+		offset: offset,
+	}
+
+	return &out
+}
+
+// end returns the offset that follows the lexeme
+func (obj *lexeme) end() int {
+	return obj.offset + len(obj.data)
+}
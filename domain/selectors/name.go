@@ -1,35 +1,92 @@
 package selectors
 
+import "regexp"
+
 type name struct {
-	isSelected  bool
-	name        string
-	insideNames []string
+	isSelected bool
+	isChild    bool
+	isLiteral  bool
+	name       string
+	pattern    *regexp.Regexp
+	insides    []Inside
+	index      Index
+	predicates []Predicate
+	repetition Repetition
 }
 
 func createName(
 	isSelected bool,
+	isChild bool,
+	isLiteral bool,
+	name string,
+	pattern *regexp.Regexp,
+	predicates []Predicate,
+	repetition Repetition,
+) Name {
+	return createNameInternally(isSelected, isChild, isLiteral, name, pattern, predicates, repetition, nil, nil)
+}
+
+func createNameWithInsides(
+	isSelected bool,
+	isChild bool,
+	isLiteral bool,
+	name string,
+	pattern *regexp.Regexp,
+	predicates []Predicate,
+	repetition Repetition,
+	insides []Inside,
+) Name {
+	return createNameInternally(isSelected, isChild, isLiteral, name, pattern, predicates, repetition, insides, nil)
+}
+
+func createNameWithIndex(
+	isSelected bool,
+	isChild bool,
+	isLiteral bool,
 	name string,
+	pattern *regexp.Regexp,
+	predicates []Predicate,
+	repetition Repetition,
+	index Index,
 ) Name {
-	return createNameInternally(isSelected, name, nil)
+	return createNameInternally(isSelected, isChild, isLiteral, name, pattern, predicates, repetition, nil, index)
 }
 
-func createNameWithInsideNames(
+func createNameWithInsidesAndIndex(
 	isSelected bool,
+	isChild bool,
+	isLiteral bool,
 	name string,
-	insideNames []string,
+	pattern *regexp.Regexp,
+	predicates []Predicate,
+	repetition Repetition,
+	insides []Inside,
+	index Index,
 ) Name {
-	return createNameInternally(isSelected, name, insideNames)
+	return createNameInternally(isSelected, isChild, isLiteral, name, pattern, predicates, repetition, insides, index)
 }
 
 func createNameInternally(
 	isSelected bool,
+	isChild bool,
+	isLiteral bool,
 	nameStr string,
-	insideNames []string,
+	pattern *regexp.Regexp,
+	predicates []Predicate,
+	repetition Repetition,
+	insides []Inside,
+	index Index,
 ) Name {
 	out := name{
-		isSelected:  isSelected,
-		name:        nameStr,
-		insideNames: insideNames,
+		isSelected: isSelected,
+		isChild:    isChild,
+		isLiteral:  isLiteral,
+		name:       nameStr,
+		pattern:    pattern,
+		insides:    insides,
+		index:      index,
+		predicates: predicates,
+		repetition: repetition,
 	}
 
 	return &out
@@ -40,17 +97,86 @@ func (obj *name) IsSelected() bool {
 	return obj.isSelected
 }
 
+// IsChild returns true if the name must be a direct child of its last inside, false otherwise
+func (obj *name) IsChild() bool {
+	return obj.isChild
+}
+
+// IsLiteral returns true if the name is matched literally, without its wildcards and alternatives, false otherwise
+func (obj *name) IsLiteral() bool {
+	return obj.isLiteral
+}
+
 // Name returns the name
 func (obj *name) Name() string {
 	return obj.name
 }
 
+// HasPattern returns true if there is a pattern, false otherwise
+func (obj *name) HasPattern() bool {
+	return obj.pattern != nil
+}
+
+// Pattern returns the pattern, if any
+func (obj *name) Pattern() *regexp.Regexp {
+	return obj.pattern
+}
+
+// HasPredicates returns true if there is predicates, false otherwise
+func (obj *name) HasPredicates() bool {
+	return obj.predicates != nil
+}
+
+// Predicates returns the predicates, if any
+func (obj *name) Predicates() []Predicate {
+	return obj.predicates
+}
+
 // HasInsideNames returns true if there is an insideNames, false otherwise
 func (obj *name) HasInsideNames() bool {
-	return obj.insideNames != nil
+	return obj.insides != nil
 }
 
 // InsideNames returns the insideNames, if any
 func (obj *name) InsideNames() []string {
-	return obj.insideNames
+	if obj.insides == nil {
+		return nil
+	}
+
+	names := []string{}
+	for _, oneInside := range obj.insides {
+		names = append(names, oneInside.Name())
+	}
+
+	return names
+}
+
+// HasInsides returns true if there is insides, false otherwise
+func (obj *name) HasInsides() bool {
+	return obj.insides != nil
+}
+
+// Insides returns the insides, if any
+func (obj *name) Insides() []Inside {
+	return obj.insides
+}
+
+// HasIndex returns true if there is an index, false otherwise
+func (obj *name) HasIndex() bool {
+	return obj.index != nil
+}
+
+// Index returns the index, if any
+func (obj *name) Index() Index {
+	return obj.index
+}
+
+// HasRepetition returns true if there is a repetition, false otherwise
+func (obj *name) HasRepetition() bool {
+	return obj.repetition != nil
+}
+
+// Repetition returns the repetition, if any
+func (obj *name) Repetition() Repetition {
+	return obj.repetition
 }
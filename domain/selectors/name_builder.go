@@ -1,18 +1,35 @@
 package selectors
 
-import "errors"
+import (
+	"errors"
+	"regexp"
+)
 
 type nameBuilder struct {
 	isSelected  bool
+	isChild     bool
+	isLiteral   bool
 	name        string
+	pattern     *regexp.Regexp
 	insideNames []string
+	insides     []Inside
+	index       Index
+	predicates  []Predicate
+	repetition  Repetition
 }
 
 func createNameBuilder() NameBuilder {
 	out := nameBuilder{
 		isSelected:  false,
+		isChild:     false,
+		isLiteral:   false,
 		name:        "",
+		pattern:     nil,
 		insideNames: nil,
+		insides:     nil,
+		index:       nil,
+		predicates:  nil,
+		repetition:  nil,
 	}
 
 	return &out
@@ -29,31 +46,112 @@ func (app *nameBuilder) IsSelected() NameBuilder {
 	return app
 }
 
+// IsChild flags the builder as a direct child of its last inside
+func (app *nameBuilder) IsChild() NameBuilder {
+	app.isChild = true
+	return app
+}
+
+// IsLiteral flags the builder as a literal name, whose wildcards and alternatives are not special
+func (app *nameBuilder) IsLiteral() NameBuilder {
+	app.isLiteral = true
+	return app
+}
+
 // WithName adds a name to the builder
 func (app *nameBuilder) WithName(name string) NameBuilder {
 	app.name = name
 	return app
 }
 
+// WithPattern adds a pattern to the builder
+func (app *nameBuilder) WithPattern(pattern *regexp.Regexp) NameBuilder {
+	app.pattern = pattern
+	return app
+}
+
 // WithInsideNames add insideNames to the builder
 func (app *nameBuilder) WithInsideNames(insideNames []string) NameBuilder {
 	app.insideNames = insideNames
 	return app
 }
 
+// WithInsides add insides to the builder
+func (app *nameBuilder) WithInsides(insides []Inside) NameBuilder {
+	app.insides = insides
+	return app
+}
+
+// WithIndex adds an index to the builder
+func (app *nameBuilder) WithIndex(index Index) NameBuilder {
+	app.index = index
+	return app
+}
+
+// WithPredicates add predicates to the builder
+func (app *nameBuilder) WithPredicates(predicates []Predicate) NameBuilder {
+	app.predicates = predicates
+	return app
+}
+
+// WithRepetition adds a repetition to the builder
+func (app *nameBuilder) WithRepetition(repetition Repetition) NameBuilder {
+	app.repetition = repetition
+	return app
+}
+
 // Now builds a new Name instance
 func (app *nameBuilder) Now() (Name, error) {
-	if app.name == "" {
-		return nil, errors.New("the name is mandatory in order to build a NameWithDelimiter instance")
+	if app.name == "" && app.pattern == nil {
+		return nil, errors.New("the name or pattern is mandatory in order to build a NameWithDelimiter instance")
+	}
+
+	if app.name != "" && app.pattern != nil {
+		return nil, errors.New("the name and pattern cannot be both provided in order to build a NameWithDelimiter instance")
+	}
+
+	if app.isLiteral && app.pattern != nil {
+		return nil, errors.New("the pattern cannot be literal in order to build a Name instance")
 	}
 
-	if app.insideNames != nil && len(app.insideNames) <= 0 {
-		app.insideNames = nil
+	if app.insideNames != nil && app.insides != nil {
+		return nil, errors.New("the insideNames and the insides cannot be both provided in order to build a Name instance")
 	}
 
 	if app.insideNames != nil {
-		return createNameWithInsideNames(app.isSelected, app.name, app.insideNames), nil
+		app.insides = []Inside{}
+		for _, oneInsideName := range app.insideNames {
+			app.insides = append(app.insides, createInside(false, false, oneInsideName, nil))
+		}
+	}
+
+	if app.predicates != nil && len(app.predicates) <= 0 {
+		app.predicates = nil
+	}
+
+	if app.insides != nil && len(app.insides) <= 0 {
+		app.insides = nil
+	}
+
+	if app.insides != nil && app.insides[0].IsChild() {
+		return nil, errors.New("the first inside cannot be a direct child")
+	}
+
+	if app.isChild && app.insides == nil {
+		return nil, errors.New("the name cannot be a direct child when there is no insides")
+	}
+
+	if app.insides != nil && app.index != nil {
+		return createNameWithInsidesAndIndex(app.isSelected, app.isChild, app.isLiteral, app.name, app.pattern, app.predicates, app.repetition, app.insides, app.index), nil
+	}
+
+	if app.insides != nil {
+		return createNameWithInsides(app.isSelected, app.isChild, app.isLiteral, app.name, app.pattern, app.predicates, app.repetition, app.insides), nil
+	}
+
+	if app.index != nil {
+		return createNameWithIndex(app.isSelected, app.isChild, app.isLiteral, app.name, app.pattern, app.predicates, app.repetition, app.index), nil
 	}
 
-	return createName(app.isSelected, app.name), nil
+	return createName(app.isSelected, app.isChild, app.isLiteral, app.name, app.pattern, app.predicates, app.repetition), nil
 }
@@ -0,0 +1,87 @@
+package selectors
+
+import (
+	"fmt"
+	"strings"
+)
+
+type parseError struct {
+	message  string
+	line     uint
+	column   uint
+	offset   uint
+	expected string
+	found    string
+	source   string
+}
+
+func createParseError(
+	message string,
+	line uint,
+	column uint,
+	offset uint,
+	expected string,
+	found string,
+	source string,
+) ParseError {
+	out := parseError{
+		message:  message,
+		line:     line,
+		column:   column,
+		offset:   offset,
+		expected: expected,
+		found:    found,
+		source:   source,
+	}
+
+	return &out
+}
+
+// Error returns the error message
+func (obj *parseError) Error() string {
+	return fmt.Sprintf("%s (line: %d, column: %d, index: %d)", obj.message, obj.line, obj.column, obj.offset)
+}
+
+// Line returns the line
+func (obj *parseError) Line() uint {
+	return obj.line
+}
+
+// Column returns the column
+func (obj *parseError) Column() uint {
+	return obj.column
+}
+
+// Offset returns the byte offset in the script
+func (obj *parseError) Offset() uint {
+	return obj.offset
+}
+
+// Expected returns what was expected
+func (obj *parseError) Expected() string {
+	return obj.expected
+}
+
+// Found returns what was found
+func (obj *parseError) Found() string {
+	return obj.found
+}
+
+// Caret returns the line of the error with a caret under its column
+func (obj *parseError) Caret() string {
+	// keep the tabs of the line so that the caret stays aligned:
+	prefix := []byte{}
+	for idx := 0; idx < int(obj.column)-1 && idx < len(obj.source); idx++ {
+		if obj.source[idx] == '\t' {
+			prefix = append(prefix, '\t')
+			continue
+		}
+
+		prefix = append(prefix, ' ')
+	}
+
+	return strings.Join([]string{
+		obj.source,
+		fmt.Sprintf("%s^", prefix),
+	}, "\n")
+}
@@ -0,0 +1,69 @@
+package selectors
+
+type predicate struct {
+	not          Selector
+	value        Value
+	pAlternative *uint
+}
+
+func createPredicateWithNot(
+	not Selector,
+) Predicate {
+	return createPredicateInternally(not, nil, nil)
+}
+
+func createPredicateWithValue(
+	value Value,
+) Predicate {
+	return createPredicateInternally(nil, value, nil)
+}
+
+func createPredicateWithAlternative(
+	pAlternative *uint,
+) Predicate {
+	return createPredicateInternally(nil, nil, pAlternative)
+}
+
+func createPredicateInternally(
+	not Selector,
+	value Value,
+	pAlternative *uint,
+) Predicate {
+	out := predicate{
+		not:          not,
+		value:        value,
+		pAlternative: pAlternative,
+	}
+
+	return &out
+}
+
+// IsNot returns true if there is a not, false otherwise
+func (obj *predicate) IsNot() bool {
+	return obj.not != nil
+}
+
+// Not returns the not selector, if any
+func (obj *predicate) Not() Selector {
+	return obj.not
+}
+
+// IsValue returns true if there is a value, false otherwise
+func (obj *predicate) IsValue() bool {
+	return obj.value != nil
+}
+
+// Value returns the value, if any
+func (obj *predicate) Value() Value {
+	return obj.value
+}
+
+// IsAlternative returns true if there is an alternative, false otherwise
+func (obj *predicate) IsAlternative() bool {
+	return obj.pAlternative != nil
+}
+
+// Alternative returns the alternative, if any
+func (obj *predicate) Alternative() *uint {
+	return obj.pAlternative
+}
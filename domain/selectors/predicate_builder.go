@@ -0,0 +1,97 @@
+package selectors
+
+import "errors"
+
+type predicateBuilder struct {
+	not          Selector
+	value        Value
+	pAlternative *uint
+}
+
+func createPredicateBuilder() PredicateBuilder {
+	out := predicateBuilder{
+		not:          nil,
+		value:        nil,
+		pAlternative: nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *predicateBuilder) Create() PredicateBuilder {
+	return createPredicateBuilder()
+}
+
+// WithNot adds a not selector to the builder
+func (app *predicateBuilder) WithNot(not Selector) PredicateBuilder {
+	app.not = not
+	return app
+}
+
+// WithValue adds a value to the builder
+func (app *predicateBuilder) WithValue(value Value) PredicateBuilder {
+	app.value = value
+	return app
+}
+
+// WithAlternative adds an alternative to the builder
+func (app *predicateBuilder) WithAlternative(alternative uint) PredicateBuilder {
+	app.pAlternative = &alternative
+	return app
+}
+
+// Now builds a new Predicate instance
+func (app *predicateBuilder) Now() (Predicate, error) {
+	if app.not != nil {
+		err := app.validateNot(app.not)
+		if err != nil {
+			return nil, err
+		}
+
+		return createPredicateWithNot(app.not), nil
+	}
+
+	if app.value != nil {
+		return createPredicateWithValue(app.value), nil
+	}
+
+	if app.pAlternative != nil {
+		if *app.pAlternative <= 0 {
+			return nil, errors.New("the alternative of a Predicate must start at 1")
+		}
+
+		return createPredicateWithAlternative(app.pAlternative), nil
+	}
+
+	return nil, errors.New("the Predicate is invalid")
+}
+
+func (app *predicateBuilder) validateNot(not Selector) error {
+	if not.IsUnion() {
+		union := not.Union()
+		for _, oneSelector := range union {
+			err := app.validateNot(oneSelector)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if !not.IsName() {
+		return errors.New("the not selector must only contain names")
+	}
+
+	name := not.Name()
+	if name.IsSelected() {
+		return errors.New("the not selector cannot contain selected names")
+	}
+
+	if name.HasIndex() {
+		return errors.New("the not selector cannot contain names with an index")
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package selectors
+
+type repetition struct {
+	index    Index
+	isList   bool
+	isAmount bool
+}
+
+func createRepetitionWithIndex(
+	index Index,
+) Repetition {
+	return createRepetitionInternally(index, false, false)
+}
+
+func createRepetitionWithList() Repetition {
+	return createRepetitionInternally(nil, true, false)
+}
+
+func createRepetitionWithAmount() Repetition {
+	return createRepetitionInternally(nil, false, true)
+}
+
+func createRepetitionInternally(
+	index Index,
+	isList bool,
+	isAmount bool,
+) Repetition {
+	out := repetition{
+		index:    index,
+		isList:   isList,
+		isAmount: isAmount,
+	}
+
+	return &out
+}
+
+// IsIndex returns true if there is an index, false otherwise
+func (obj *repetition) IsIndex() bool {
+	return obj.index != nil
+}
+
+// Index returns the index, if any
+func (obj *repetition) Index() Index {
+	return obj.index
+}
+
+// IsList returns true if every repetition is selected separately, false otherwise
+func (obj *repetition) IsList() bool {
+	return obj.isList
+}
+
+// IsAmount returns true if the amount of repetitions is selected, false otherwise
+func (obj *repetition) IsAmount() bool {
+	return obj.isAmount
+}
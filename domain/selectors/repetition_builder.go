@@ -0,0 +1,59 @@
+package selectors
+
+import "errors"
+
+type repetitionBuilder struct {
+	index    Index
+	isList   bool
+	isAmount bool
+}
+
+func createRepetitionBuilder() RepetitionBuilder {
+	out := repetitionBuilder{
+		index:    nil,
+		isList:   false,
+		isAmount: false,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *repetitionBuilder) Create() RepetitionBuilder {
+	return createRepetitionBuilder()
+}
+
+// WithIndex adds an index to the builder
+func (app *repetitionBuilder) WithIndex(index Index) RepetitionBuilder {
+	app.index = index
+	return app
+}
+
+// IsList flags the builder as a list
+func (app *repetitionBuilder) IsList() RepetitionBuilder {
+	app.isList = true
+	return app
+}
+
+// IsAmount flags the builder as an amount
+func (app *repetitionBuilder) IsAmount() RepetitionBuilder {
+	app.isAmount = true
+	return app
+}
+
+// Now builds a new Repetition instance
+func (app *repetitionBuilder) Now() (Repetition, error) {
+	if app.index != nil {
+		return createRepetitionWithIndex(app.index), nil
+	}
+
+	if app.isList {
+		return createRepetitionWithList(), nil
+	}
+
+	if app.isAmount {
+		return createRepetitionWithAmount(), nil
+	}
+
+	return nil, errors.New("the Repetition is invalid")
+}
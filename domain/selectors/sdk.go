@@ -1,14 +1,88 @@
 package selectors
 
-// NewAdapter creates a new selector adapter instance
+import "regexp"
+
+// NewAdapter creates a new selector adapter instance, whose lexemes are separated by channel characters
 func NewAdapter() Adapter {
+	tokenNameCharacters := []byte("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ_")
+	tokenNameFollowing := []byte("0123456789")
+	return newAdapter(tokenNameCharacters, tokenNameFollowing, false)
+}
+
+// NewAdapterWithTokenNameCharacters creates a new selector adapter instance whose token names start with one of the characters,
+// followed by the characters or the following characters, in order to match the token names allowed by the grammar
+func NewAdapterWithTokenNameCharacters(characters []byte, following []byte) Adapter {
+	return newAdapter(characters, following, false)
+}
+
+// NewCompatibilityAdapter creates a new selector adapter instance that keeps the permissive behavior
+// of the previous versions, where every channel character is removed from the script before parsing it
+func NewCompatibilityAdapter() Adapter {
+	tokenNameCharacters := []byte("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ_")
+	tokenNameFollowing := []byte("0123456789")
+	return newAdapter(tokenNameCharacters, tokenNameFollowing, true)
+}
+
+func newAdapter(tokenNameCharacters []byte, tokenNameFollowing []byte, isCompatible bool) Adapter {
+	statementsBuilder := NewStatementsBuilder()
+	statementBuilder := NewStatementBuilder()
 	selectorBuilder := NewBuilder()
+	siblingBuilder := NewSiblingBuilder()
+	ancestorBuilder := NewAncestorBuilder()
+	betweenBuilder := NewBetweenBuilder()
+	allBuilder := NewAllBuilder()
+	channelBuilder := NewChannelBuilder()
 	nameBuilder := NewNameBuilder()
+	insideBuilder := NewInsideBuilder()
+	predicateBuilder := NewPredicateBuilder()
+	valueBuilder := NewValueBuilder()
+	repetitionBuilder := NewRepetitionBuilder()
+	indexBuilder := NewIndexBuilder()
+	sliceBuilder := NewSliceBuilder()
 	anyByte := []byte("*")[0]
 	tokenNameByte := []byte(".")[0]
 	insideByte := []byte("@")[0]
+	childByte := []byte(">")[0]
 	selectByte := []byte("+")[0]
-	tokenNameCharacters := []byte("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")
+	unionByte := []byte(",")[0]
+	siblingByte := []byte("~")[0]
+	precedingByte := []byte("<")[0]
+	parentByte := []byte("^")[0]
+	rangeBytes := []byte("..")
+	axisPrefix := []byte("::")
+	assignmentByte := []byte("=")[0]
+	statementSuffix := []byte(";")[0]
+	statementDelimiter := []byte("\n")[0]
+	lineCommentPrefix := []byte("//")
+	blockCommentPrefix := []byte("/*")
+	blockCommentSuffix := []byte("*/")
+	predicatePrefix := []byte(":")[0]
+	notKeyword := []byte("not")
+	alternativeKeyword := []byte("alt")
+	channelKeyword := []byte("channel")
+	alternativeByte := []byte("#")[0]
+	parametersPrefix := []byte("(")[0]
+	parametersSuffix := []byte(")")[0]
+	equalByte := []byte("=")[0]
+	prefixByte := []byte("^")[0]
+	containsByte := []byte("*")[0]
+	patternByte := []byte("~")[0]
+	quoteByte := []byte("\"")[0]
+	repetitionPrefix := []byte("{")[0]
+	repetitionSuffix := []byte("}")[0]
+	repetitionListByte := []byte("*")[0]
+	repetitionAmountByte := []byte("#")[0]
+	indexPrefix := []byte("[")[0]
+	indexSuffix := []byte("]")[0]
+	sliceDelimiter := []byte(":")[0]
+	negativeByte := []byte("-")[0]
+	separatorByte := []byte(" ")[0]
+	wildcardCharacters := []byte("*?")
+	alternativesPrefix := []byte("{")[0]
+	alternativesSuffix := []byte("}")[0]
+	alternativesDelimiter := []byte(",")[0]
+	patternDelimiter := []byte("/")[0]
+	escapeByte := []byte("\\")[0]
 	channelCharacters := []byte{
 		[]byte("\t")[0],
 		[]byte("\n")[0],
@@ -17,31 +91,195 @@ func NewAdapter() Adapter {
 	}
 
 	return createAdapter(
+		statementsBuilder,
+		statementBuilder,
 		selectorBuilder,
+		siblingBuilder,
+		ancestorBuilder,
+		betweenBuilder,
+		allBuilder,
+		channelBuilder,
 		nameBuilder,
+		insideBuilder,
+		predicateBuilder,
+		valueBuilder,
+		repetitionBuilder,
+		indexBuilder,
+		sliceBuilder,
 		anyByte,
 		tokenNameByte,
 		insideByte,
+		childByte,
 		selectByte,
+		unionByte,
+		siblingByte,
+		precedingByte,
+		parentByte,
+		rangeBytes,
+		axisPrefix,
+		assignmentByte,
+		statementSuffix,
+		statementDelimiter,
+		lineCommentPrefix,
+		blockCommentPrefix,
+		blockCommentSuffix,
+		predicatePrefix,
+		notKeyword,
+		alternativeKeyword,
+		channelKeyword,
+		alternativeByte,
+		parametersPrefix,
+		parametersSuffix,
+		equalByte,
+		prefixByte,
+		containsByte,
+		patternByte,
+		quoteByte,
+		repetitionPrefix,
+		repetitionSuffix,
+		repetitionListByte,
+		repetitionAmountByte,
+		indexPrefix,
+		indexSuffix,
+		sliceDelimiter,
+		negativeByte,
+		separatorByte,
 		tokenNameCharacters,
+		tokenNameFollowing,
+		wildcardCharacters,
+		alternativesPrefix,
+		alternativesSuffix,
+		alternativesDelimiter,
+		patternDelimiter,
+		escapeByte,
 		channelCharacters,
+		isCompatible,
 	)
 }
 
+// NewStatementsBuilder creates a new statements builder
+func NewStatementsBuilder() StatementsBuilder {
+	return createStatementsBuilder()
+}
+
+// NewStatementBuilder creates a new statement builder
+func NewStatementBuilder() StatementBuilder {
+	return createStatementBuilder()
+}
+
 // NewBuilder creates a new selector builder
 func NewBuilder() Builder {
 	return createBuilder()
 }
 
+// NewSiblingBuilder creates a new sibling builder
+func NewSiblingBuilder() SiblingBuilder {
+	return createSiblingBuilder()
+}
+
+// NewAncestorBuilder creates a new ancestor builder
+func NewAncestorBuilder() AncestorBuilder {
+	return createAncestorBuilder()
+}
+
+// NewBetweenBuilder creates a new between builder
+func NewBetweenBuilder() BetweenBuilder {
+	return createBetweenBuilder()
+}
+
+// NewAllBuilder creates a new all builder
+func NewAllBuilder() AllBuilder {
+	return createAllBuilder()
+}
+
+// NewChannelBuilder creates a new channel builder
+func NewChannelBuilder() ChannelBuilder {
+	return createChannelBuilder()
+}
+
 // NewNameBuilder creates a new name builder
 func NewNameBuilder() NameBuilder {
 	return createNameBuilder()
 }
 
+// NewInsideBuilder creates a new inside builder
+func NewInsideBuilder() InsideBuilder {
+	return createInsideBuilder()
+}
+
+// NewPredicateBuilder creates a new predicate builder
+func NewPredicateBuilder() PredicateBuilder {
+	return createPredicateBuilder()
+}
+
+// NewValueBuilder creates a new value builder
+func NewValueBuilder() ValueBuilder {
+	return createValueBuilder()
+}
+
+// NewRepetitionBuilder creates a new repetition builder
+func NewRepetitionBuilder() RepetitionBuilder {
+	return createRepetitionBuilder()
+}
+
+// NewIndexBuilder creates a new index builder
+func NewIndexBuilder() IndexBuilder {
+	return createIndexBuilder()
+}
+
+// NewSliceBuilder creates a new slice builder
+func NewSliceBuilder() SliceBuilder {
+	return createSliceBuilder()
+}
+
 // Adapter represents the selector adapter
 type Adapter interface {
 	ToScript(selector Selector) []byte
 	ToSelector(script string) (Selector, []byte, error)
+	ToStrictSelector(script string) (Selector, error)
+	StatementsToScript(statements Statements) []byte
+	ToStatements(script string) (Statements, []byte, error)
+	ToStrictStatements(script string) (Statements, error)
+	FormatSelector(script string) ([]byte, error)
+	FormatStatements(script string) ([]byte, error)
+}
+
+// ParseError represents a positioned error of a parsed script, it can be retrieved using errors.As
+type ParseError interface {
+	Error() string
+	Line() uint
+	Column() uint
+	Offset() uint
+	Expected() string
+	Found() string
+	Caret() string
+}
+
+// StatementsBuilder represents a statements builder
+type StatementsBuilder interface {
+	Create() StatementsBuilder
+	WithList(list []Statement) StatementsBuilder
+	Now() (Statements, error)
+}
+
+// Statements represents a list of named statements
+type Statements interface {
+	List() []Statement
+	Fetch(name string) (Statement, error)
+}
+
+// StatementBuilder represents a statement builder
+type StatementBuilder interface {
+	Create() StatementBuilder
+	WithName(name string) StatementBuilder
+	WithSelector(selector Selector) StatementBuilder
+	Now() (Statement, error)
+}
+
+// Statement represents a selector assigned to a name
+type Statement interface {
+	Name() string
+	Selector() Selector
 }
 
 // Builder represents a selector builder
@@ -49,6 +287,12 @@ type Builder interface {
 	Create() Builder
 	WithName(name Name) Builder
 	WithAny(any Name) Builder
+	WithUnion(union []Selector) Builder
+	WithSibling(sibling Sibling) Builder
+	WithAncestor(ancestor Ancestor) Builder
+	WithBetween(between Between) Builder
+	WithAll(all All) Builder
+	WithChannel(channel Channel) Builder
 	Now() (Selector, error)
 }
 
@@ -58,21 +302,242 @@ type Selector interface {
 	Name() Name
 	IsAny() bool
 	Any() Name
+	IsUnion() bool
+	Union() []Selector
+	IsSibling() bool
+	Sibling() Sibling
+	IsAncestor() bool
+	Ancestor() Ancestor
+	IsBetween() bool
+	Between() Between
+	IsAll() bool
+	All() All
+	IsChannel() bool
+	Channel() Channel
+}
+
+// SiblingBuilder represents a sibling builder
+type SiblingBuilder interface {
+	Create() SiblingBuilder
+	WithName(name Name) SiblingBuilder
+	WithTarget(target Name) SiblingBuilder
+	IsPreceding() SiblingBuilder
+	Now() (Sibling, error)
+}
+
+// Sibling represents the siblings of the matched tokens, within the same line of their parent token.
+// The index of the target is applied to the siblings of each matched token, in document order
+type Sibling interface {
+	Name() Name
+	Target() Name
+	IsPreceding() bool
+}
+
+// AncestorBuilder represents an ancestor builder
+type AncestorBuilder interface {
+	Create() AncestorBuilder
+	WithName(name Name) AncestorBuilder
+	WithTarget(target Name) AncestorBuilder
+	Now() (Ancestor, error)
+}
+
+// Ancestor represents the parent of the matched tokens, or their nearest ancestor matching its target
+type Ancestor interface {
+	Name() Name
+	HasTarget() bool
+	Target() Name
+}
+
+// BetweenBuilder represents a between builder
+type BetweenBuilder interface {
+	Create() BetweenBuilder
+	WithFrom(from Name) BetweenBuilder
+	WithTo(to Name) BetweenBuilder
+	IsInclusive() BetweenBuilder
+	Now() (Between, error)
+}
+
+// Between represents the bytes between the matched tokens and the nearest following token matching its to name
+type Between interface {
+	From() Name
+	To() Name
+	IsInclusive() bool
+}
+
+// AllBuilder represents an all builder
+type AllBuilder interface {
+	Create() AllBuilder
+	IsSelected() AllBuilder
+	WithInsides(insides []Inside) AllBuilder
+	Now() (All, error)
+}
+
+// All represents all the bytes of the root token, or of the tokens matched by its insides, which are returned
+// whether or not the all is written with its selection prefix
+type All interface {
+	IsSelected() bool
+	HasInsides() bool
+	Insides() []Inside
+}
+
+// ChannelBuilder represents a channel builder
+type ChannelBuilder interface {
+	Create() ChannelBuilder
+	WithName(name Name) ChannelBuilder
+	WithTarget(target Name) ChannelBuilder
+	Now() (Channel, error)
+}
+
+// Channel represents the channel tokens consumed before and after the matched tokens.
+// The index of the target is applied to the channel tokens of each matched token, in document order
+type Channel interface {
+	Name() Name
+	Target() Name
 }
 
 // NameBuilder represents a name builder
 type NameBuilder interface {
 	Create() NameBuilder
 	IsSelected() NameBuilder
+	IsChild() NameBuilder
+	IsLiteral() NameBuilder
 	WithName(name string) NameBuilder
+	WithPattern(pattern *regexp.Regexp) NameBuilder
 	WithInsideNames(insideNames []string) NameBuilder
+	WithInsides(insides []Inside) NameBuilder
+	WithIndex(index Index) NameBuilder
+	WithPredicates(predicates []Predicate) NameBuilder
+	WithRepetition(repetition Repetition) NameBuilder
 	Now() (Name, error)
 }
 
-// Name represents a name
+// Name represents a name, matched against the token names either by its glob name, its literal name or its pattern
 type Name interface {
 	Name() string
 	IsSelected() bool
+	IsChild() bool
+	IsLiteral() bool
+	HasPattern() bool
+	Pattern() *regexp.Regexp
 	HasInsideNames() bool
 	InsideNames() []string
+	HasInsides() bool
+	Insides() []Inside
+	HasIndex() bool
+	Index() Index
+	HasPredicates() bool
+	Predicates() []Predicate
+	HasRepetition() bool
+	Repetition() Repetition
+}
+
+// InsideBuilder represents an inside builder
+type InsideBuilder interface {
+	Create() InsideBuilder
+	IsChild() InsideBuilder
+	IsLiteral() InsideBuilder
+	WithName(name string) InsideBuilder
+	WithPattern(pattern *regexp.Regexp) InsideBuilder
+	Now() (Inside, error)
+}
+
+// Inside represents a token name the selected name must be inside of
+type Inside interface {
+	IsChild() bool
+	IsLiteral() bool
+	Name() string
+	HasPattern() bool
+	Pattern() *regexp.Regexp
+}
+
+// PredicateBuilder represents a predicate builder
+type PredicateBuilder interface {
+	Create() PredicateBuilder
+	WithNot(not Selector) PredicateBuilder
+	WithValue(value Value) PredicateBuilder
+	WithAlternative(alternative uint) PredicateBuilder
+	Now() (Predicate, error)
+}
+
+// Predicate represents a predicate the matched tokens must satisfy,
+// the alternative is the position of the token's matched line, starting at 1 for its first alternative
+type Predicate interface {
+	IsNot() bool
+	Not() Selector
+	IsValue() bool
+	Value() Value
+	IsAlternative() bool
+	Alternative() *uint
+}
+
+// ValueBuilder represents a value builder
+type ValueBuilder interface {
+	Create() ValueBuilder
+	WithEqual(equal []byte) ValueBuilder
+	WithPrefix(prefix []byte) ValueBuilder
+	WithContains(contains []byte) ValueBuilder
+	WithPattern(pattern *regexp.Regexp) ValueBuilder
+	Now() (Value, error)
+}
+
+// Value represents a value the extracted bytes of the matched tokens must satisfy
+type Value interface {
+	IsEqual() bool
+	Equal() []byte
+	IsPrefix() bool
+	Prefix() []byte
+	IsContains() bool
+	Contains() []byte
+	IsPattern() bool
+	Pattern() *regexp.Regexp
+}
+
+// RepetitionBuilder represents a repetition builder
+type RepetitionBuilder interface {
+	Create() RepetitionBuilder
+	WithIndex(index Index) RepetitionBuilder
+	IsList() RepetitionBuilder
+	IsAmount() RepetitionBuilder
+	Now() (Repetition, error)
+}
+
+// Repetition represents the repetitions to select within each element of the matched tokens,
+// instead of joining the consecutive repetitions of an element in a single result
+type Repetition interface {
+	IsIndex() bool
+	Index() Index
+	IsList() bool
+	IsAmount() bool
+}
+
+// IndexBuilder represents an index builder
+type IndexBuilder interface {
+	Create() IndexBuilder
+	WithPosition(position int) IndexBuilder
+	WithSlice(slice Slice) IndexBuilder
+	Now() (Index, error)
+}
+
+// Index represents the index of the matched tokens to keep, negative values are counted from the end
+type Index interface {
+	IsPosition() bool
+	Position() *int
+	IsSlice() bool
+	Slice() Slice
+}
+
+// SliceBuilder represents a slice builder
+type SliceBuilder interface {
+	Create() SliceBuilder
+	WithFrom(from int) SliceBuilder
+	WithTo(to int) SliceBuilder
+	Now() (Slice, error)
+}
+
+// Slice represents a slice of matched tokens, the to index is excluded
+type Slice interface {
+	HasFrom() bool
+	From() *int
+	HasTo() bool
+	To() *int
 }
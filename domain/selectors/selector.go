@@ -1,29 +1,83 @@
 package selectors
 
 type selector struct {
-	name Name
-	any  Name
+	name     Name
+	any      Name
+	union    []Selector
+	sibling  Sibling
+	ancestor Ancestor
+	between  Between
+	all      All
+	channel  Channel
 }
 
 func createSelectorWithName(
 	name Name,
 ) Selector {
-	return createSelectorInternally(name, nil)
+	return createSelectorInternally(name, nil, nil, nil, nil, nil, nil, nil)
 }
 
 func createSelectorWithAnySelector(
 	any Name,
 ) Selector {
-	return createSelectorInternally(nil, any)
+	return createSelectorInternally(nil, any, nil, nil, nil, nil, nil, nil)
+}
+
+func createSelectorWithUnion(
+	union []Selector,
+) Selector {
+	return createSelectorInternally(nil, nil, union, nil, nil, nil, nil, nil)
+}
+
+func createSelectorWithSibling(
+	sibling Sibling,
+) Selector {
+	return createSelectorInternally(nil, nil, nil, sibling, nil, nil, nil, nil)
+}
+
+func createSelectorWithAncestor(
+	ancestor Ancestor,
+) Selector {
+	return createSelectorInternally(nil, nil, nil, nil, ancestor, nil, nil, nil)
+}
+
+func createSelectorWithBetween(
+	between Between,
+) Selector {
+	return createSelectorInternally(nil, nil, nil, nil, nil, between, nil, nil)
+}
+
+func createSelectorWithAll(
+	all All,
+) Selector {
+	return createSelectorInternally(nil, nil, nil, nil, nil, nil, all, nil)
+}
+
+func createSelectorWithChannel(
+	channel Channel,
+) Selector {
+	return createSelectorInternally(nil, nil, nil, nil, nil, nil, nil, channel)
 }
 
 func createSelectorInternally(
 	name Name,
 	any Name,
+	union []Selector,
+	sibling Sibling,
+	ancestor Ancestor,
+	between Between,
+	all All,
+	channel Channel,
 ) Selector {
 	out := selector{
-		name: name,
-		any:  any,
+		name:     name,
+		any:      any,
+		union:    union,
+		sibling:  sibling,
+		ancestor: ancestor,
+		between:  between,
+		all:      all,
+		channel:  channel,
 	}
 
 	return &out
@@ -48,3 +102,63 @@ func (obj *selector) IsAny() bool {
 func (obj *selector) Any() Name {
 	return obj.any
 }
+
+// IsUnion returns true if union, false otherwise
+func (obj *selector) IsUnion() bool {
+	return obj.union != nil
+}
+
+// Union returns the union, if any
+func (obj *selector) Union() []Selector {
+	return obj.union
+}
+
+// IsSibling returns true if sibling, false otherwise
+func (obj *selector) IsSibling() bool {
+	return obj.sibling != nil
+}
+
+// Sibling returns the sibling, if any
+func (obj *selector) Sibling() Sibling {
+	return obj.sibling
+}
+
+// IsAncestor returns true if ancestor, false otherwise
+func (obj *selector) IsAncestor() bool {
+	return obj.ancestor != nil
+}
+
+// Ancestor returns the ancestor, if any
+func (obj *selector) Ancestor() Ancestor {
+	return obj.ancestor
+}
+
+// IsBetween returns true if between, false otherwise
+func (obj *selector) IsBetween() bool {
+	return obj.between != nil
+}
+
+// Between returns the between, if any
+func (obj *selector) Between() Between {
+	return obj.between
+}
+
+// IsAll returns true if all, false otherwise
+func (obj *selector) IsAll() bool {
+	return obj.all != nil
+}
+
+// All returns the all, if any
+func (obj *selector) All() All {
+	return obj.all
+}
+
+// IsChannel returns true if channel, false otherwise
+func (obj *selector) IsChannel() bool {
+	return obj.channel != nil
+}
+
+// Channel returns the channel, if any
+func (obj *selector) Channel() Channel {
+	return obj.channel
+}
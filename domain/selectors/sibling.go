@@ -0,0 +1,36 @@
+package selectors
+
+type sibling struct {
+	name        Name
+	target      Name
+	isPreceding bool
+}
+
+func createSibling(
+	name Name,
+	target Name,
+	isPreceding bool,
+) Sibling {
+	out := sibling{
+		name:        name,
+		target:      target,
+		isPreceding: isPreceding,
+	}
+
+	return &out
+}
+
+// Name returns the name of the token whose siblings are selected
+func (obj *sibling) Name() Name {
+	return obj.name
+}
+
+// Target returns the name of the selected siblings
+func (obj *sibling) Target() Name {
+	return obj.target
+}
+
+// IsPreceding returns true if the preceding siblings are selected, false if the following siblings are
+func (obj *sibling) IsPreceding() bool {
+	return obj.isPreceding
+}
@@ -0,0 +1,63 @@
+package selectors
+
+import "errors"
+
+type siblingBuilder struct {
+	name        Name
+	target      Name
+	isPreceding bool
+}
+
+func createSiblingBuilder() SiblingBuilder {
+	out := siblingBuilder{
+		name:        nil,
+		target:      nil,
+		isPreceding: false,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *siblingBuilder) Create() SiblingBuilder {
+	return createSiblingBuilder()
+}
+
+// WithName adds a name to the builder
+func (app *siblingBuilder) WithName(name Name) SiblingBuilder {
+	app.name = name
+	return app
+}
+
+// WithTarget adds a target to the builder
+func (app *siblingBuilder) WithTarget(target Name) SiblingBuilder {
+	app.target = target
+	return app
+}
+
+// IsPreceding flags the builder as preceding
+func (app *siblingBuilder) IsPreceding() SiblingBuilder {
+	app.isPreceding = true
+	return app
+}
+
+// Now builds a new Sibling instance
+func (app *siblingBuilder) Now() (Sibling, error) {
+	if app.name == nil {
+		return nil, errors.New("the name is mandatory in order to build a Sibling instance")
+	}
+
+	if app.target == nil {
+		return nil, errors.New("the target is mandatory in order to build a Sibling instance")
+	}
+
+	if app.target.IsSelected() {
+		return nil, errors.New("the target of a Sibling cannot be selected, the selection belongs to its name")
+	}
+
+	if app.target.HasInsides() {
+		return nil, errors.New("the target of a Sibling cannot contain insides")
+	}
+
+	return createSibling(app.name, app.target, app.isPreceding), nil
+}
@@ -0,0 +1,61 @@
+package selectors
+
+type slice struct {
+	pFrom *int
+	pTo   *int
+}
+
+func createSlice() Slice {
+	return createSliceInternally(nil, nil)
+}
+
+func createSliceWithFrom(
+	pFrom *int,
+) Slice {
+	return createSliceInternally(pFrom, nil)
+}
+
+func createSliceWithTo(
+	pTo *int,
+) Slice {
+	return createSliceInternally(nil, pTo)
+}
+
+func createSliceWithFromAndTo(
+	pFrom *int,
+	pTo *int,
+) Slice {
+	return createSliceInternally(pFrom, pTo)
+}
+
+func createSliceInternally(
+	pFrom *int,
+	pTo *int,
+) Slice {
+	out := slice{
+		pFrom: pFrom,
+		pTo:   pTo,
+	}
+
+	return &out
+}
+
+// HasFrom returns true if there is a from index, false otherwise
+func (obj *slice) HasFrom() bool {
+	return obj.pFrom != nil
+}
+
+// From returns the from index, if any
+func (obj *slice) From() *int {
+	return obj.pFrom
+}
+
+// HasTo returns true if there is a to index, false otherwise
+func (obj *slice) HasTo() bool {
+	return obj.pTo != nil
+}
+
+// To returns the to index, if any
+func (obj *slice) To() *int {
+	return obj.pTo
+}
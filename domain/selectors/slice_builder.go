@@ -0,0 +1,49 @@
+package selectors
+
+type sliceBuilder struct {
+	pFrom *int
+	pTo   *int
+}
+
+func createSliceBuilder() SliceBuilder {
+	out := sliceBuilder{
+		pFrom: nil,
+		pTo:   nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *sliceBuilder) Create() SliceBuilder {
+	return createSliceBuilder()
+}
+
+// WithFrom adds a from index to the builder
+func (app *sliceBuilder) WithFrom(from int) SliceBuilder {
+	app.pFrom = &from
+	return app
+}
+
+// WithTo adds a to index to the builder
+func (app *sliceBuilder) WithTo(to int) SliceBuilder {
+	app.pTo = &to
+	return app
+}
+
+// Now builds a new Slice instance
+func (app *sliceBuilder) Now() (Slice, error) {
+	if app.pFrom != nil && app.pTo != nil {
+		return createSliceWithFromAndTo(app.pFrom, app.pTo), nil
+	}
+
+	if app.pFrom != nil {
+		return createSliceWithFrom(app.pFrom), nil
+	}
+
+	if app.pTo != nil {
+		return createSliceWithTo(app.pTo), nil
+	}
+
+	return createSlice(), nil
+}
@@ -0,0 +1,28 @@
+package selectors
+
+type statement struct {
+	name     string
+	selector Selector
+}
+
+func createStatement(
+	name string,
+	selector Selector,
+) Statement {
+	out := statement{
+		name:     name,
+		selector: selector,
+	}
+
+	return &out
+}
+
+// Name returns the name
+func (obj *statement) Name() string {
+	return obj.name
+}
+
+// Selector returns the selector
+func (obj *statement) Selector() Selector {
+	return obj.selector
+}
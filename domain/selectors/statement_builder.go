@@ -0,0 +1,47 @@
+package selectors
+
+import "errors"
+
+type statementBuilder struct {
+	name     string
+	selector Selector
+}
+
+func createStatementBuilder() StatementBuilder {
+	out := statementBuilder{
+		name:     "",
+		selector: nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *statementBuilder) Create() StatementBuilder {
+	return createStatementBuilder()
+}
+
+// WithName adds a name to the builder
+func (app *statementBuilder) WithName(name string) StatementBuilder {
+	app.name = name
+	return app
+}
+
+// WithSelector adds a selector to the builder
+func (app *statementBuilder) WithSelector(selector Selector) StatementBuilder {
+	app.selector = selector
+	return app
+}
+
+// Now builds a new Statement instance
+func (app *statementBuilder) Now() (Statement, error) {
+	if app.name == "" {
+		return nil, errors.New("the name is mandatory in order to build a Statement instance")
+	}
+
+	if app.selector == nil {
+		return nil, errors.New("the selector is mandatory in order to build a Statement instance")
+	}
+
+	return createStatement(app.name, app.selector), nil
+}
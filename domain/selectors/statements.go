@@ -0,0 +1,38 @@
+package selectors
+
+import (
+	"errors"
+	"fmt"
+)
+
+type statements struct {
+	list []Statement
+	mp   map[string]Statement
+}
+
+func createStatements(
+	list []Statement,
+	mp map[string]Statement,
+) Statements {
+	out := statements{
+		list: list,
+		mp:   mp,
+	}
+
+	return &out
+}
+
+// List returns the statements
+func (obj *statements) List() []Statement {
+	return obj.list
+}
+
+// Fetch fetches a statement by name
+func (obj *statements) Fetch(name string) (Statement, error) {
+	if ins, ok := obj.mp[name]; ok {
+		return ins, nil
+	}
+
+	str := fmt.Sprintf("the statement (name: %s) is undefined", name)
+	return nil, errors.New(str)
+}
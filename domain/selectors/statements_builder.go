@@ -0,0 +1,53 @@
+package selectors
+
+import (
+	"errors"
+	"fmt"
+)
+
+type statementsBuilder struct {
+	list []Statement
+}
+
+func createStatementsBuilder() StatementsBuilder {
+	out := statementsBuilder{
+		list: nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *statementsBuilder) Create() StatementsBuilder {
+	return createStatementsBuilder()
+}
+
+// WithList adds a list to the builder
+func (app *statementsBuilder) WithList(list []Statement) StatementsBuilder {
+	app.list = list
+	return app
+}
+
+// Now builds a new Statements instance
+func (app *statementsBuilder) Now() (Statements, error) {
+	if app.list != nil && len(app.list) <= 0 {
+		app.list = nil
+	}
+
+	if app.list == nil {
+		return nil, errors.New("there must be at least 1 Statement in order to build a Statements instance")
+	}
+
+	mp := map[string]Statement{}
+	for _, oneStatement := range app.list {
+		name := oneStatement.Name()
+		if _, ok := mp[name]; ok {
+			str := fmt.Sprintf("the statement (name: %s) is declared more than once", name)
+			return nil, errors.New(str)
+		}
+
+		mp[name] = oneStatement
+	}
+
+	return createStatements(app.list, mp), nil
+}
@@ -0,0 +1,27 @@
+package selectors
+
+// syntaxError represents an error of the parser, positioned by the length of the data remaining after it
+type syntaxError struct {
+	message   string
+	expected  string
+	remaining int
+}
+
+func createSyntaxError(
+	message string,
+	expected string,
+	remaining int,
+) *syntaxError {
+	out := syntaxError{
+		message:   message,
+		expected:  expected,
+		remaining: remaining,
+	}
+
+	return &out
+}
+
+// Error returns the error message
+func (obj *syntaxError) Error() string {
+	return obj.message
+}
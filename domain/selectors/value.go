@@ -0,0 +1,90 @@
+package selectors
+
+import "regexp"
+
+type value struct {
+	equal    []byte
+	prefix   []byte
+	contains []byte
+	pattern  *regexp.Regexp
+}
+
+func createValueWithEqual(
+	equal []byte,
+) Value {
+	return createValueInternally(equal, nil, nil, nil)
+}
+
+func createValueWithPrefix(
+	prefix []byte,
+) Value {
+	return createValueInternally(nil, prefix, nil, nil)
+}
+
+func createValueWithContains(
+	contains []byte,
+) Value {
+	return createValueInternally(nil, nil, contains, nil)
+}
+
+func createValueWithPattern(
+	pattern *regexp.Regexp,
+) Value {
+	return createValueInternally(nil, nil, nil, pattern)
+}
+
+func createValueInternally(
+	equal []byte,
+	prefix []byte,
+	contains []byte,
+	pattern *regexp.Regexp,
+) Value {
+	out := value{
+		equal:    equal,
+		prefix:   prefix,
+		contains: contains,
+		pattern:  pattern,
+	}
+
+	return &out
+}
+
+// IsEqual returns true if there is an equal value, false otherwise
+func (obj *value) IsEqual() bool {
+	return obj.equal != nil
+}
+
+// Equal returns the equal value, if any
+func (obj *value) Equal() []byte {
+	return obj.equal
+}
+
+// IsPrefix returns true if there is a prefix value, false otherwise
+func (obj *value) IsPrefix() bool {
+	return obj.prefix != nil
+}
+
+// Prefix returns the prefix value, if any
+func (obj *value) Prefix() []byte {
+	return obj.prefix
+}
+
+// IsContains returns true if there is a contains value, false otherwise
+func (obj *value) IsContains() bool {
+	return obj.contains != nil
+}
+
+// Contains returns the contains value, if any
+func (obj *value) Contains() []byte {
+	return obj.contains
+}
+
+// IsPattern returns true if there is a pattern, false otherwise
+func (obj *value) IsPattern() bool {
+	return obj.pattern != nil
+}
+
+// Pattern returns the pattern, if any
+func (obj *value) Pattern() *regexp.Regexp {
+	return obj.pattern
+}
@@ -0,0 +1,74 @@
+package selectors
+
+import (
+	"errors"
+	"regexp"
+)
+
+type valueBuilder struct {
+	equal    []byte
+	prefix   []byte
+	contains []byte
+	pattern  *regexp.Regexp
+}
+
+func createValueBuilder() ValueBuilder {
+	out := valueBuilder{
+		equal:    nil,
+		prefix:   nil,
+		contains: nil,
+		pattern:  nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *valueBuilder) Create() ValueBuilder {
+	return createValueBuilder()
+}
+
+// WithEqual adds an equal value to the builder
+func (app *valueBuilder) WithEqual(equal []byte) ValueBuilder {
+	app.equal = equal
+	return app
+}
+
+// WithPrefix adds a prefix value to the builder
+func (app *valueBuilder) WithPrefix(prefix []byte) ValueBuilder {
+	app.prefix = prefix
+	return app
+}
+
+// WithContains adds a contains value to the builder
+func (app *valueBuilder) WithContains(contains []byte) ValueBuilder {
+	app.contains = contains
+	return app
+}
+
+// WithPattern adds a pattern to the builder
+func (app *valueBuilder) WithPattern(pattern *regexp.Regexp) ValueBuilder {
+	app.pattern = pattern
+	return app
+}
+
+// Now builds a new Value instance
+func (app *valueBuilder) Now() (Value, error) {
+	if app.equal != nil {
+		return createValueWithEqual(app.equal), nil
+	}
+
+	if app.prefix != nil {
+		return createValueWithPrefix(app.prefix), nil
+	}
+
+	if app.contains != nil {
+		return createValueWithContains(app.contains), nil
+	}
+
+	if app.pattern != nil {
+		return createValueWithPattern(app.pattern), nil
+	}
+
+	return nil, errors.New("the Value is invalid")
+}